@@ -3,6 +3,7 @@ module github.com/golang/mock
 require (
 	golang.org/x/mod v0.5.1
 	golang.org/x/tools v0.1.8
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 go 1.15
@@ -15,21 +15,31 @@
 package gomock
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // Call represents an expected call to a mock.
 type Call struct {
+	// numFastCalls is the number of calls made without taking the
+	// controller's lock. It is first to be 64-bit aligned for atomic access.
+	numFastCalls int64
+
 	t TestHelper // for triggering test failures on invalid call setup
 
 	receiver   interface{}  // the receiver of the method call
 	method     string       // the name of the method
 	methodType reflect.Type // the type of the method
 	args       []Matcher    // the args
-	origin     string       // file and line number of call setup
+	originPCs  []uintptr    // stack of call setup, resolved by origin
+
+	originOnce sync.Once
+	originStr  string
 
 	preReqs []*Call // prerequisite calls
 
@@ -38,18 +48,36 @@ type Call struct {
 
 	numCalls int // actual number made
 
+	defaults   *defaultValues // values returned when no action returns any; may be nil
+	formatters *formatters    // formatters of values in failure messages; may be nil
+
+	stub bool   // whether the call defines default behavior and is not verified
+	seq  uint64 // order in which calls were created
+
 	// actions are called when this Call is called. Each action gets the args and
 	// can set the return values by returning a non-nil slice. Actions run in the
 	// order they are created.
 	actions []func([]interface{}) []interface{}
 }
 
+// callSeq counts the calls created, to order them.
+var callSeq uint64
+
 // newCall creates a *Call. It requires the method type in order to support
 // unexported methods.
 func newCall(t TestHelper, receiver interface{}, method string, methodType reflect.Type, args ...interface{}) *Call {
 	t.Helper()
 
-	// TODO: check arity, types.
+	// callers' skip should be updated if the number of calls between the user's test
+	// and this line changes, i.e. this code is wrapped in another anonymous function.
+	// 0 is us, 1 is RecordCallWithMethodType(), 2 is the generated recorder, and 3 is the user's test.
+	// Resolving the stack to a file and line is left until it is needed.
+	pcs := callers(3)
+
+	if err := checkArgs(methodType, args); err != nil {
+		t.Fatalf("invalid expected call of %T.%v: %v [%s]", receiver, method, err, frameInfo(pcs))
+	}
+
 	mArgs := make([]Matcher, len(args))
 	for i, arg := range args {
 		if m, ok := arg.(Matcher); ok {
@@ -63,20 +91,69 @@ func newCall(t TestHelper, receiver interface{}, method string, methodType refle
 		}
 	}
 
-	// callerInfo's skip should be updated if the number of calls between the user's test
-	// and this line changes, i.e. this code is wrapped in another anonymous function.
-	// 0 is us, 1 is RecordCallWithMethodType(), 2 is the generated recorder, and 3 is the user's test.
-	origin := callerInfo(3)
-	actions := []func([]interface{}) []interface{}{func([]interface{}) []interface{} {
-		// Synthesize the zero value for each of the return args' types.
-		rets := make([]interface{}, methodType.NumOut())
-		for i := 0; i < methodType.NumOut(); i++ {
-			rets[i] = reflect.Zero(methodType.Out(i)).Interface()
-		}
-		return rets
+	c := &Call{t: t, receiver: receiver, method: method, methodType: methodType,
+		args: mArgs, originPCs: pcs, minCalls: 1, maxCalls: 1, seq: atomic.AddUint64(&callSeq, 1)}
+	c.actions = []func([]interface{}) []interface{}{func([]interface{}) []interface{} {
+		// Synthesize the default value for each of the return args' types.
+		return c.defaults.returns(methodType)
 	}}
-	return &Call{t: t, receiver: receiver, method: method, methodType: methodType,
-		args: mArgs, origin: origin, minCalls: 1, maxCalls: 1, actions: actions}
+	return c
+}
+
+// checkArgs verifies that args, the matchers or values given when recording an
+// expected call, agree in number with the parameters of methodType, and that
+// any non-matcher values could ever match the corresponding parameter.
+func checkArgs(methodType reflect.Type, args []interface{}) error {
+	numIn := methodType.NumIn()
+	if !methodType.IsVariadic() {
+		if len(args) != numIn {
+			return fmt.Errorf("wrong number of arguments: got %d, want %d", len(args), numIn)
+		}
+	} else if len(args) < numIn-1 {
+		return fmt.Errorf("wrong number of arguments: got %d, want at least %d", len(args), numIn-1)
+	}
+
+	for i, arg := range args {
+		if _, ok := arg.(Matcher); ok {
+			continue
+		}
+		var want []reflect.Type
+		switch {
+		case !methodType.IsVariadic() || i < numIn-1:
+			want = []reflect.Type{methodType.In(i)}
+		case i == numIn-1 && len(args) == numIn:
+			// The last argument may stand for the whole variadic slice or
+			// for its only element.
+			want = []reflect.Type{methodType.In(i), methodType.In(numIn - 1).Elem()}
+		default:
+			want = []reflect.Type{methodType.In(numIn - 1).Elem()}
+		}
+		if !canMatch(arg, want) {
+			if arg == nil {
+				return fmt.Errorf("argument %d is nil, but %v is not nillable", i, want[0])
+			}
+			return fmt.Errorf("wrong type of argument %d: %T is not assignable to %v", i, arg, want[0])
+		}
+	}
+	return nil
+}
+
+// canMatch reports whether the value x can be equal to a value of one of the
+// types in want.
+func canMatch(x interface{}, want []reflect.Type) bool {
+	for _, w := range want {
+		if x == nil {
+			switch w.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+				return true
+			}
+			continue
+		}
+		if reflect.TypeOf(x).AssignableTo(w) {
+			return true
+		}
+	}
+	return false
 }
 
 // AnyTimes allows the expectation to be called 0 or more times
@@ -122,7 +199,7 @@ func (c *Call) DoAndReturn(f interface{}) *Call {
 					c.receiver, c.method)
 			} else {
 				c.t.Fatalf("wrong number of arguments in DoAndReturn func for %T.%v: got %d, want %d [%s]",
-					c.receiver, c.method, ft.NumIn(), c.methodType.NumIn(), c.origin)
+					c.receiver, c.method, ft.NumIn(), c.methodType.NumIn(), c.origin())
 			}
 			return nil
 		}
@@ -140,6 +217,11 @@ func (c *Call) DoAndReturn(f interface{}) *Call {
 		for i, ret := range vRets {
 			rets[i] = ret.Interface()
 		}
+		// Unlike the values given to Return, the results can only be checked
+		// once the function has returned them.
+		if err := c.checkResults(rets); err != nil {
+			c.t.Fatalf("%v", err)
+		}
 		return rets
 	})
 	return c
@@ -163,7 +245,7 @@ func (c *Call) Do(f interface{}) *Call {
 					c.receiver, c.method)
 			} else {
 				c.t.Fatalf("wrong number of arguments in Do func for %T.%v: got %d, want %d [%s]",
-					c.receiver, c.method, ft.NumIn(), c.methodType.NumIn(), c.origin)
+					c.receiver, c.method, ft.NumIn(), c.methodType.NumIn(), c.origin())
 			}
 			return nil
 		}
@@ -189,7 +271,7 @@ func (c *Call) Return(rets ...interface{}) *Call {
 	mt := c.methodType
 	if len(rets) != mt.NumOut() {
 		c.t.Fatalf("wrong number of arguments to Return for %T.%v: got %d, want %d [%s]",
-			c.receiver, c.method, len(rets), mt.NumOut(), c.origin)
+			c.receiver, c.method, len(rets), mt.NumOut(), c.origin())
 	}
 	for i, ret := range rets {
 		if got, want := reflect.TypeOf(ret), mt.Out(i); got == want {
@@ -201,7 +283,7 @@ func (c *Call) Return(rets ...interface{}) *Call {
 				// ok
 			default:
 				c.t.Fatalf("argument %d to Return for %T.%v is nil, but %v is not nillable [%s]",
-					i, c.receiver, c.method, want, c.origin)
+					i, c.receiver, c.method, want, c.origin())
 			}
 		} else if got.AssignableTo(want) {
 			// Assignable type relation. Make the assignment now so that the generated code
@@ -211,7 +293,7 @@ func (c *Call) Return(rets ...interface{}) *Call {
 			rets[i] = v.Interface()
 		} else {
 			c.t.Fatalf("wrong type of argument %d to Return for %T.%v: %v is not assignable to %v [%s]",
-				i, c.receiver, c.method, got, want, c.origin)
+				i, c.receiver, c.method, got, want, c.origin())
 		}
 	}
 
@@ -222,6 +304,42 @@ func (c *Call) Return(rets ...interface{}) *Call {
 	return c
 }
 
+// checkResults checks that rets, the results of a DoAndReturn function, can be
+// returned by the method of the call. Results of types assignable to the result
+// types are converted to them, so that the generated code can type assert them.
+func (c *Call) checkResults(rets []interface{}) error {
+	mt := c.methodType
+	if len(rets) != mt.NumOut() {
+		return fmt.Errorf("wrong number of results of %T.%v: got %d, want %d [%s]",
+			c.receiver, c.method, len(rets), mt.NumOut(), c.origin())
+	}
+	for i, ret := range rets {
+		got, want := reflect.TypeOf(ret), mt.Out(i)
+		switch {
+		case got == want:
+			continue
+		case got == nil:
+			switch want.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+				continue
+			}
+			return fmt.Errorf("result %d of %T.%v is nil, but %v is not nillable [%s]",
+				i, c.receiver, c.method, want, c.origin())
+		case !got.AssignableTo(want):
+			return fmt.Errorf("wrong type of result %d of %T.%v: %v is not assignable to %v [%s]",
+				i, c.receiver, c.method, got, want, c.origin())
+		}
+		if want.Kind() == reflect.Interface {
+			// The generated code's type assertion succeeds as is.
+			continue
+		}
+		v := reflect.New(want).Elem()
+		v.Set(reflect.ValueOf(ret))
+		rets[i] = v.Interface()
+	}
+	return nil
+}
+
 // Times declares the exact number of times a function call is expected to be executed.
 func (c *Call) Times(n int) *Call {
 	c.minCalls, c.maxCalls = n, n
@@ -239,7 +357,7 @@ func (c *Call) SetArg(n int, value interface{}) *Call {
 	// We will need to check those at invocation time.
 	if n < 0 || n >= mt.NumIn() {
 		c.t.Fatalf("SetArg(%d, ...) called for a method with %d args [%s]",
-			n, mt.NumIn(), c.origin)
+			n, mt.NumIn(), c.origin())
 	}
 	// Permit setting argument through an interface.
 	// In the interface case, we don't (nay, can't) check the type here.
@@ -249,7 +367,7 @@ func (c *Call) SetArg(n int, value interface{}) *Call {
 		dt := at.Elem()
 		if vt := reflect.TypeOf(value); !vt.AssignableTo(dt) {
 			c.t.Fatalf("SetArg(%d, ...) argument is a %v, not assignable to %v [%s]",
-				n, vt, dt, c.origin)
+				n, vt, dt, c.origin())
 		}
 	case reflect.Interface:
 		// nothing to do
@@ -259,7 +377,7 @@ func (c *Call) SetArg(n int, value interface{}) *Call {
 		// nothing to do
 	default:
 		c.t.Fatalf("SetArg(%d, ...) referring to argument of non-pointer non-interface non-slice non-map type %v [%s]",
-			n, at, c.origin)
+			n, at, c.origin())
 	}
 
 	c.addAction(func(args []interface{}) []interface{} {
@@ -277,6 +395,136 @@ func (c *Call) SetArg(n int, value interface{}) *Call {
 	return c
 }
 
+// InvokeArg declares an action that calls the nth argument, which must be a
+// function, with the given values. The function's return values are ignored.
+// The number and types of values are checked against the function's signature
+// when the expectation is recorded if the argument has a func type, and when
+// the action runs otherwise.
+func (c *Call) InvokeArg(n int, args ...interface{}) *Call {
+	c.t.Helper()
+
+	c.checkInvokeArg("InvokeArg", n, args)
+	c.addAction(func(callArgs []interface{}) []interface{} {
+		c.t.Helper()
+		fn, vArgs, err := funcArgCall(callArgs, n, args)
+		if err != nil {
+			c.t.Fatalf("InvokeArg(%d, ...) for %T.%v: %v [%s]", n, c.receiver, c.method, err, c.origin())
+			return nil
+		}
+		fn.Call(vArgs)
+		return nil
+	})
+	return c
+}
+
+// InvokeArgAsync is like InvokeArg, but calls the function from a new
+// goroutine. The action does not wait for the function to return. The values
+// are checked against the function's signature before the goroutine starts,
+// so failures are reported from the call like those of InvokeArg.
+func (c *Call) InvokeArgAsync(n int, args ...interface{}) *Call {
+	c.t.Helper()
+
+	c.checkInvokeArg("InvokeArgAsync", n, args)
+	c.addAction(func(callArgs []interface{}) []interface{} {
+		c.t.Helper()
+		fn, vArgs, err := funcArgCall(callArgs, n, args)
+		if err != nil {
+			c.t.Fatalf("InvokeArgAsync(%d, ...) for %T.%v: %v [%s]", n, c.receiver, c.method, err, c.origin())
+			return nil
+		}
+		go fn.Call(vArgs)
+		return nil
+	})
+	return c
+}
+
+// checkInvokeArg verifies that the nth argument of the method can be invoked
+// with args, reporting a fatal failure at the call site of name otherwise.
+func (c *Call) checkInvokeArg(name string, n int, args []interface{}) {
+	c.t.Helper()
+
+	mt := c.methodType
+	var at reflect.Type
+	switch {
+	case n >= 0 && n < mt.NumIn() && !(mt.IsVariadic() && n == mt.NumIn()-1):
+		at = mt.In(n)
+	case n >= 0 && mt.IsVariadic() && n >= mt.NumIn()-1:
+		at = mt.In(mt.NumIn() - 1).Elem()
+	default:
+		c.t.Fatalf("%s(%d, ...) called for a method with %d args [%s]",
+			name, n, mt.NumIn(), c.origin())
+		return
+	}
+	switch at.Kind() {
+	case reflect.Func:
+		if _, err := funcArgValues(at, args); err != nil {
+			c.t.Fatalf("%s(%d, ...) for %T.%v: %v [%s]", name, n, c.receiver, c.method, err, c.origin())
+		}
+	case reflect.Interface:
+		// The dynamic type is only known once the call is made.
+	default:
+		c.t.Fatalf("%s(%d, ...) referring to argument of non-func non-interface type %v [%s]",
+			name, n, at, c.origin())
+	}
+}
+
+// funcArgCall returns the function in callArgs[n], and args converted into
+// values that can be passed to it.
+func funcArgCall(callArgs []interface{}, n int, args []interface{}) (reflect.Value, []reflect.Value, error) {
+	if n >= len(callArgs) {
+		return reflect.Value{}, nil, fmt.Errorf("the call has only %d args", len(callArgs))
+	}
+	fn := reflect.ValueOf(callArgs[n])
+	if fn.Kind() != reflect.Func {
+		return reflect.Value{}, nil, fmt.Errorf("argument %d is a %T, not a function", n, callArgs[n])
+	}
+	if fn.IsNil() {
+		return reflect.Value{}, nil, fmt.Errorf("argument %d is a nil %v", n, fn.Type())
+	}
+	vArgs, err := funcArgValues(fn.Type(), args)
+	if err != nil {
+		return reflect.Value{}, nil, err
+	}
+	return fn, vArgs, nil
+}
+
+// funcArgValues converts args into values that can be passed to a function of
+// type ft, or returns an error describing why they cannot.
+func funcArgValues(ft reflect.Type, args []interface{}) ([]reflect.Value, error) {
+	if ft.IsVariadic() {
+		if len(args) < ft.NumIn()-1 {
+			return nil, fmt.Errorf("wrong number of arguments for %v: got %d, want at least %d",
+				ft, len(args), ft.NumIn()-1)
+		}
+	} else if len(args) != ft.NumIn() {
+		return nil, fmt.Errorf("wrong number of arguments for %v: got %d, want %d",
+			ft, len(args), ft.NumIn())
+	}
+	vArgs := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var want reflect.Type
+		if ft.IsVariadic() && i >= ft.NumIn()-1 {
+			want = ft.In(ft.NumIn() - 1).Elem()
+		} else {
+			want = ft.In(i)
+		}
+		if arg == nil {
+			switch want.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+				vArgs[i] = reflect.Zero(want)
+				continue
+			}
+			return nil, fmt.Errorf("argument %d for %v is nil, but %v is not nillable", i, ft, want)
+		}
+		got := reflect.TypeOf(arg)
+		if !got.AssignableTo(want) {
+			return nil, fmt.Errorf("wrong type of argument %d for %v: %v is not assignable to %v", i, ft, got, want)
+		}
+		vArgs[i] = reflect.ValueOf(arg)
+	}
+	return vArgs, nil
+}
+
 // isPreReq returns true if other is a direct or indirect prerequisite to c.
 func (c *Call) isPreReq(other *Call) bool {
 	for _, preReq := range c.preReqs {
@@ -302,62 +550,139 @@ func (c *Call) After(preReq *Call) *Call {
 	return c
 }
 
+// origin returns the file and line number of call setup.
+func (c *Call) origin() string {
+	c.originOnce.Do(func() {
+		c.originStr = frameInfo(c.originPCs)
+	})
+	return c.originStr
+}
+
+// count returns the number of calls made.
+func (c *Call) count() int {
+	return c.numCalls + int(atomic.LoadInt64(&c.numFastCalls))
+}
+
 // Returns true if the minimum number of calls have been made.
 func (c *Call) satisfied() bool {
-	return c.numCalls >= c.minCalls
+	return c.count() >= c.minCalls
 }
 
 // Returns true if the maximum number of calls have been made.
 func (c *Call) exhausted() bool {
-	return c.numCalls >= c.maxCalls
+	return c.count() >= c.maxCalls
+}
+
+// fast reports whether the call can be matched and called without taking the
+// controller's lock: it may be called any number of times, and does not
+// depend on other calls.
+func (c *Call) fast() bool {
+	return c.maxCalls >= 1e8 && len(c.preReqs) == 0
 }
 
 func (c *Call) String() string {
 	args := make([]string, len(c.args))
 	for i, arg := range c.args {
-		args[i] = arg.String()
+		args[i] = describe(arg, c.formatters)
 	}
 	arguments := strings.Join(args, ", ")
-	return fmt.Sprintf("%T.%v(%s) %s", c.receiver, c.method, arguments, c.origin)
+	return fmt.Sprintf("%T.%v(%s) %s", c.receiver, c.method, arguments, c.origin())
 }
 
 // Tests if the given call matches the expected call.
 // If yes, returns nil. If no, returns error with message explaining why it does not match.
 func (c *Call) matches(args []interface{}) error {
+	return c.match(args, true)
+}
+
+// errMismatch is returned by match when a call does not match and no
+// explanation was asked for.
+var errMismatch = errors.New("call does not match")
+
+// match is like matches, but unless explain is set it returns errMismatch
+// rather than formatting an explanation, which is expensive.
+func (c *Call) match(args []interface{}, explain bool) error {
+	if err := c.matchArgs(args, explain); err != nil {
+		return err
+	}
+
+	// Check that all prerequisite calls have been satisfied.
+	for _, preReqCall := range c.preReqs {
+		if !preReqCall.satisfied() {
+			if !explain {
+				return errMismatch
+			}
+			return fmt.Errorf("expected call at %s doesn't have a prerequisite call satisfied:\n%v\nshould be called before:\n%v",
+				c.origin(), preReqCall, c)
+		}
+	}
+
+	// Check that the call is not exhausted.
+	if c.exhausted() {
+		if !explain {
+			return errMismatch
+		}
+		return fmt.Errorf("expected call at %s has already been called the max number of times", c.origin())
+	}
+
+	return nil
+}
+
+// matchArgs is like match, but only checks the arguments of the call and not
+// whether it may be called now.
+func (c *Call) matchArgs(args []interface{}, explain bool) error {
 	if !c.methodType.IsVariadic() {
 		if len(args) != len(c.args) {
+			if !explain {
+				return errMismatch
+			}
 			return fmt.Errorf("expected call at %s has the wrong number of arguments. Got: %d, want: %d",
-				c.origin, len(args), len(c.args))
+				c.origin(), len(args), len(c.args))
 		}
 
 		for i, m := range c.args {
 			if !m.Matches(args[i]) {
+				if !explain {
+					return errMismatch
+				}
 				return fmt.Errorf(
 					"expected call at %s doesn't match the argument at index %d.\nGot: %v\nWant: %v",
-					c.origin, i, formatGottenArg(m, args[i]), m,
+					c.origin(), i, c.formatGottenArg(m, args[i]), describe(m, c.formatters),
 				)
 			}
 		}
 	} else {
 		if len(c.args) < c.methodType.NumIn()-1 {
+			if !explain {
+				return errMismatch
+			}
 			return fmt.Errorf("expected call at %s has the wrong number of matchers. Got: %d, want: %d",
-				c.origin, len(c.args), c.methodType.NumIn()-1)
+				c.origin(), len(c.args), c.methodType.NumIn()-1)
 		}
 		if len(c.args) != c.methodType.NumIn() && len(args) != len(c.args) {
+			if !explain {
+				return errMismatch
+			}
 			return fmt.Errorf("expected call at %s has the wrong number of arguments. Got: %d, want: %d",
-				c.origin, len(args), len(c.args))
+				c.origin(), len(args), len(c.args))
 		}
 		if len(args) < len(c.args)-1 {
+			if !explain {
+				return errMismatch
+			}
 			return fmt.Errorf("expected call at %s has the wrong number of arguments. Got: %d, want: greater than or equal to %d",
-				c.origin, len(args), len(c.args)-1)
+				c.origin(), len(args), len(c.args)-1)
 		}
 
 		for i, m := range c.args {
 			if i < c.methodType.NumIn()-1 {
 				// Non-variadic args
 				if !m.Matches(args[i]) {
+					if !explain {
+						return errMismatch
+					}
 					return fmt.Errorf("expected call at %s doesn't match the argument at index %s.\nGot: %v\nWant: %v",
-						c.origin, strconv.Itoa(i), formatGottenArg(m, args[i]), m)
+						c.origin(), strconv.Itoa(i), c.formatGottenArg(m, args[i]), describe(m, c.formatters))
 				}
 				continue
 			}
@@ -400,24 +725,14 @@ func (c *Call) matches(args []interface{}) error {
 			// Got Foo(a, b, c, d, e) want Foo(matcherA, matcherB, matcherC, matcherD)
 			// Got Foo(a, b, c) want Foo(matcherA, matcherB)
 
+			if !explain {
+				return errMismatch
+			}
 			return fmt.Errorf("expected call at %s doesn't match the argument at index %s.\nGot: %v\nWant: %v",
-				c.origin, strconv.Itoa(i), formatGottenArg(m, args[i:]), c.args[i])
-		}
-	}
-
-	// Check that all prerequisite calls have been satisfied.
-	for _, preReqCall := range c.preReqs {
-		if !preReqCall.satisfied() {
-			return fmt.Errorf("expected call at %s doesn't have a prerequisite call satisfied:\n%v\nshould be called before:\n%v",
-				c.origin, preReqCall, c)
+				c.origin(), strconv.Itoa(i), c.formatGottenArg(m, args[i:]), describe(c.args[i], c.formatters))
 		}
 	}
 
-	// Check that the call is not exhausted.
-	if c.exhausted() {
-		return fmt.Errorf("expected call at %s has already been called the max number of times", c.origin)
-	}
-
 	return nil
 }
 
@@ -425,7 +740,9 @@ func (c *Call) matches(args []interface{}) error {
 // longer, and to return its current set.
 func (c *Call) dropPrereqs() (preReqs []*Call) {
 	preReqs = c.preReqs
-	c.preReqs = nil
+	if preReqs != nil {
+		c.preReqs = nil
+	}
 	return
 }
 
@@ -462,10 +779,9 @@ func (c *Call) addAction(action func([]interface{}) []interface{}) {
 	c.actions = append(c.actions, action)
 }
 
-func formatGottenArg(m Matcher, arg interface{}) string {
-	got := fmt.Sprintf("%v (%T)", arg, arg)
+func (c *Call) formatGottenArg(m Matcher, arg interface{}) string {
 	if gs, ok := m.(GotFormatter); ok {
-		got = gs.Got(arg)
+		return gs.Got(arg)
 	}
-	return got
+	return fmt.Sprintf("%s (%T)", c.formatters.format(arg), arg)
 }
@@ -16,7 +16,10 @@ package gomock
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -616,3 +619,200 @@ func TestCall_DoAndReturn(t *testing.T) {
 		})
 	}
 }
+
+func TestCall_InvokeArg(t *testing.T) {
+	tests := []struct {
+		name       string
+		methodType reflect.Type
+		n          int
+		invokeArgs []interface{}
+		wantFatal  bool
+	}{
+		{
+			name:       "func arg",
+			methodType: reflect.TypeOf(func(string, func(int, string)) {}),
+			n:          1,
+			invokeArgs: []interface{}{1, "one"},
+		},
+		{
+			name:       "out of range",
+			methodType: reflect.TypeOf(func(func()) {}),
+			n:          1,
+			wantFatal:  true,
+		},
+		{
+			name:       "not a func",
+			methodType: reflect.TypeOf(func(int) {}),
+			n:          0,
+			wantFatal:  true,
+		},
+		{
+			name:       "wrong arity",
+			methodType: reflect.TypeOf(func(func(int)) {}),
+			n:          0,
+			invokeArgs: []interface{}{1, 2},
+			wantFatal:  true,
+		},
+		{
+			name:       "wrong type",
+			methodType: reflect.TypeOf(func(func(int)) {}),
+			n:          0,
+			invokeArgs: []interface{}{"one"},
+			wantFatal:  true,
+		},
+		{
+			name:       "nil for nillable type",
+			methodType: reflect.TypeOf(func(func(error)) {}),
+			n:          0,
+			invokeArgs: []interface{}{nil},
+		},
+		{
+			name:       "nil for non-nillable type",
+			methodType: reflect.TypeOf(func(func(int)) {}),
+			n:          0,
+			invokeArgs: []interface{}{nil},
+			wantFatal:  true,
+		},
+		{
+			name:       "variadic func",
+			methodType: reflect.TypeOf(func(func(string, ...int)) {}),
+			n:          0,
+			invokeArgs: []interface{}{"a", 1, 2},
+		},
+		{
+			name:       "variadic method",
+			methodType: reflect.TypeOf(func(int, ...func(int)) {}),
+			n:          2,
+			invokeArgs: []interface{}{1},
+		},
+		{
+			name:       "interface arg is checked later",
+			methodType: reflect.TypeOf(func(interface{}) {}),
+			n:          0,
+			invokeArgs: []interface{}{"anything"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &mockTestReporter{}
+			call := &Call{
+				t:          tr,
+				methodType: tt.methodType,
+			}
+			call.InvokeArg(tt.n, tt.invokeArgs...)
+			if tt.wantFatal && tr.fatalCalls != 1 {
+				t.Fatalf("expected call to fail")
+			}
+			if !tt.wantFatal && tr.fatalCalls != 0 {
+				t.Fatalf("expected call to pass")
+			}
+		})
+	}
+}
+
+func TestCall_InvokeArg_Action(t *testing.T) {
+	t.Run("CallsFunction", func(t *testing.T) {
+		tr := &mockTestReporter{}
+		c := &Call{t: tr, methodType: reflect.TypeOf(func(string, func(int, string)) {})}
+		c.InvokeArg(1, 7, "seven")
+
+		var gotN int
+		var gotS string
+		c.actions[0]([]interface{}{"x", func(n int, s string) { gotN, gotS = n, s }})
+
+		if tr.fatalCalls != 0 {
+			t.Fatalf("number of fatal calls == %v, want 0", tr.fatalCalls)
+		}
+		if gotN != 7 || gotS != "seven" {
+			t.Errorf("callback got (%v, %q), want (7, \"seven\")", gotN, gotS)
+		}
+	})
+
+	t.Run("InterfaceArgWithWrongFunction", func(t *testing.T) {
+		tr := &mockTestReporter{}
+		c := &Call{t: tr, methodType: reflect.TypeOf(func(interface{}) {})}
+		c.InvokeArg(0, "one")
+
+		c.actions[0]([]interface{}{func(int) {}})
+
+		if tr.fatalCalls != 1 {
+			t.Errorf("number of fatal calls == %v, want 1", tr.fatalCalls)
+		}
+	})
+
+	t.Run("NilFunction", func(t *testing.T) {
+		tr := &mockTestReporter{}
+		c := &Call{t: tr, methodType: reflect.TypeOf(func(func()) {})}
+		c.InvokeArg(0)
+
+		c.actions[0]([]interface{}{(func())(nil)})
+
+		if tr.fatalCalls != 1 {
+			t.Errorf("number of fatal calls == %v, want 1", tr.fatalCalls)
+		}
+	})
+
+	t.Run("Async", func(t *testing.T) {
+		tr := &mockTestReporter{}
+		c := &Call{t: tr, methodType: reflect.TypeOf(func(func(int)) {})}
+		c.InvokeArgAsync(0, 3)
+
+		done := make(chan int)
+		c.actions[0]([]interface{}{func(n int) { done <- n }})
+
+		if got := <-done; got != 3 {
+			t.Errorf("callback got %v, want 3", got)
+		}
+		if tr.fatalCalls != 0 || tr.errorCalls != 0 {
+			t.Error("unexpected errors")
+		}
+	})
+
+	t.Run("AsyncInterfaceArgWithWrongFunction", func(t *testing.T) {
+		tr := &mockTestReporter{}
+		c := &Call{t: tr, methodType: reflect.TypeOf(func(interface{}) {})}
+		c.InvokeArgAsync(0, "one")
+
+		// The failure is reported by the action, before it returns.
+		c.actions[0]([]interface{}{func(int) {}})
+
+		if tr.fatalCalls != 1 {
+			t.Errorf("number of fatal calls == %v, want 1", tr.fatalCalls)
+		}
+	})
+}
+
+type uploader struct{}
+
+func (u *uploader) Upload(r io.Reader) error {
+	return nil
+}
+
+func TestCall_StreamsAreForgotten(t *testing.T) {
+	ctrl := NewController(&mockTestReporter{})
+	u := &uploader{}
+	var uploaded string
+	ctrl.RecordCall(u, "Upload", ReaderEq([]byte("hello"))).Do(func(r io.Reader) {
+		b, _ := ioutil.ReadAll(r)
+		uploaded = string(b)
+	})
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("hello"))
+		pw.Close()
+	}()
+	ctrl.Call(u, "Upload", pr)
+	if uploaded != "hello" {
+		t.Errorf("uploaded %q, want %q", uploaded, "hello")
+	}
+
+	// Readers read outside of a call aren't held either.
+	ReaderEq([]byte("hello")).Matches(strings.NewReader("hello"))
+
+	streams.Lock()
+	defer streams.Unlock()
+	if len(streams.m) != 0 {
+		t.Errorf("%v readers are still held after the calls", len(streams.m))
+	}
+}
@@ -27,6 +27,11 @@ type callSet struct {
 	expected map[callSetKey][]*Call
 	// Calls that have been exhausted.
 	exhausted map[callSetKey][]*Call
+	// Calls that define default behavior but are not verified.
+	stubs map[callSetKey][]*Call
+	// Receivers that must not be called anymore, with where they were
+	// forbidden.
+	forbidden map[interface{}]string
 }
 
 // callSetKey is the key in the maps in callSet
@@ -36,7 +41,8 @@ type callSetKey struct {
 }
 
 func newCallSet() *callSet {
-	return &callSet{make(map[callSetKey][]*Call), make(map[callSetKey][]*Call)}
+	return &callSet{make(map[callSetKey][]*Call), make(map[callSetKey][]*Call), make(map[callSetKey][]*Call),
+		make(map[interface{}]string)}
 }
 
 // Add adds a new expected call.
@@ -49,6 +55,12 @@ func (cs callSet) Add(call *Call) {
 	m[key] = append(m[key], call)
 }
 
+// AddStub adds a new stub call.
+func (cs callSet) AddStub(call *Call) {
+	key := callSetKey{call.receiver, call.method}
+	cs.stubs[key] = append(cs.stubs[key], call)
+}
+
 // Remove removes an expected call.
 func (cs callSet) Remove(call *Call) {
 	key := callSetKey{call.receiver, call.method}
@@ -63,25 +75,101 @@ func (cs callSet) Remove(call *Call) {
 	}
 }
 
+// Forbid makes every later call on receiver fail to match. origin is where
+// the receiver was forbidden.
+func (cs callSet) Forbid(receiver interface{}, origin string) {
+	cs.forbidden[receiver] = origin
+}
+
+// Forbidden reports whether calls on receiver are forbidden.
+func (cs callSet) Forbidden(receiver interface{}) bool {
+	_, ok := cs.forbidden[receiver]
+	return ok
+}
+
+// Retire moves all expected calls on receiver to the exhausted calls, and
+// returns the ones that are not satisfied.
+func (cs callSet) Retire(receiver interface{}) []*Call {
+	var failures []*Call
+	for key, calls := range cs.expected {
+		if key.receiver != receiver {
+			continue
+		}
+		for _, call := range calls {
+			if !call.satisfied() {
+				failures = append(failures, call)
+			}
+		}
+		cs.exhausted[key] = append(cs.exhausted[key], calls...)
+		delete(cs.expected, key)
+	}
+	return failures
+}
+
+// streamArgs reports which of the n arguments of a call to key are compared to
+// stream matchers by its expected, exhausted or stub calls.
+func (cs callSet) streamArgs(key callSetKey, n int) []bool {
+	read := make([]bool, n)
+	for _, calls := range [][]*Call{cs.expected[key], cs.exhausted[key], cs.stubs[key]} {
+		for _, call := range calls {
+			for i, m := range call.args {
+				if i < n && isStreamMatcher(m) {
+					read[i] = true
+				}
+			}
+		}
+	}
+	return read
+}
+
 // FindMatch searches for a matching call. Returns error with explanation message if no call matched.
 func (cs callSet) FindMatch(receiver interface{}, method string, args []interface{}) (*Call, error) {
+	if origin, ok := cs.forbidden[receiver]; ok {
+		return nil, fmt.Errorf("no more calls to this receiver are allowed since %s", origin)
+	}
+
 	key := callSetKey{receiver, method}
 
-	// Search through the expected calls.
+	// Search through the expected calls, then fall back to the stubs, most
+	// recently added first, so that later stubs override earlier ones. The
+	// stubs only apply to calls that match no expected call, so a call that
+	// matches an exhausted one fails even if a stub matches it.
 	expected := cs.expected[key]
-	var callsErrors bytes.Buffer
 	for _, call := range expected {
-		err := call.matches(args)
-		if err != nil {
-			_, _ = fmt.Fprintf(&callsErrors, "\n%v", err)
-		} else {
+		if call.match(args, false) == nil {
 			return call, nil
 		}
 	}
+	exhausted := cs.exhausted[key]
+	overCalled := false
+	for _, call := range exhausted {
+		if call.matchArgs(args, false) == nil {
+			overCalled = true
+			break
+		}
+	}
+	stubs := cs.stubs[key]
+	if !overCalled {
+		for i := len(stubs) - 1; i >= 0; i-- {
+			if stubs[i].match(args, false) == nil {
+				return stubs[i], nil
+			}
+		}
+	}
+
+	// No call matched, so explain why each of them did not.
+	var callsErrors bytes.Buffer
+	for _, call := range expected {
+		_, _ = fmt.Fprintf(&callsErrors, "\n%v", call.matches(args))
+	}
+	if !overCalled {
+		for i := len(stubs) - 1; i >= 0; i-- {
+			_, _ = fmt.Fprintf(&callsErrors, "\n%v", stubs[i].matches(args))
+		}
+	}
 
 	// If we haven't found a match then search through the exhausted calls so we
 	// get useful error messages.
-	exhausted := cs.exhausted[key]
 	for _, call := range exhausted {
 		if err := call.matches(args); err != nil {
 			_, _ = fmt.Fprintf(&callsErrors, "\n%v", err)
@@ -92,7 +180,7 @@ func (cs callSet) FindMatch(receiver interface{}, method string, args []interfac
 		)
 	}
 
-	if len(expected)+len(exhausted) == 0 {
+	if len(expected)+len(exhausted)+len(stubs) == 0 {
 		_, _ = fmt.Fprintf(&callsErrors, "there are no expected calls of the method %q for that receiver", method)
 	}
 
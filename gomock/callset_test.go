@@ -97,3 +97,97 @@ func TestCallSetFindMatch(t *testing.T) {
 		}
 	})
 }
+
+func TestCallSetFindMatchStubs(t *testing.T) {
+	var receiver interface{} = "TestReceiver"
+	method := "TestMethod"
+	methodType := reflect.TypeOf(func(int) {})
+
+	t.Run("expected calls take precedence", func(t *testing.T) {
+		cs := newCallSet()
+		stub := newCall(t, receiver, method, methodType, Any())
+		expected := newCall(t, receiver, method, methodType, 1)
+		cs.AddStub(stub)
+		cs.Add(expected)
+
+		if call, err := cs.FindMatch(receiver, method, []interface{}{1}); err != nil || call != expected {
+			t.Errorf("FindMatch(1) = %v, %v; want the expected call", call, err)
+		}
+		if call, err := cs.FindMatch(receiver, method, []interface{}{2}); err != nil || call != stub {
+			t.Errorf("FindMatch(2) = %v, %v; want the stub", call, err)
+		}
+	})
+
+	t.Run("exhausted calls take precedence", func(t *testing.T) {
+		cs := newCallSet()
+		cs.AddStub(newCall(t, receiver, method, methodType, Any()))
+		cs.Add(newCall(t, receiver, method, methodType, 1).Times(0))
+
+		if call, err := cs.FindMatch(receiver, method, []interface{}{1}); err == nil {
+			t.Errorf("FindMatch(1) = %v; want an error", call)
+		}
+	})
+
+	t.Run("later stubs take precedence", func(t *testing.T) {
+		cs := newCallSet()
+		first := newCall(t, receiver, method, methodType, Any())
+		second := newCall(t, receiver, method, methodType, 2)
+		cs.AddStub(first)
+		cs.AddStub(second)
+
+		if call, err := cs.FindMatch(receiver, method, []interface{}{2}); err != nil || call != second {
+			t.Errorf("FindMatch(2) = %v, %v; want the second stub", call, err)
+		}
+		if call, err := cs.FindMatch(receiver, method, []interface{}{1}); err != nil || call != first {
+			t.Errorf("FindMatch(1) = %v, %v; want the first stub", call, err)
+		}
+	})
+
+	t.Run("stubs are not failures", func(t *testing.T) {
+		cs := newCallSet()
+		cs.AddStub(newCall(t, receiver, method, methodType, Any()))
+
+		if failures := cs.Failures(); len(failures) != 0 {
+			t.Errorf("Failures() = %v, want none", failures)
+		}
+	})
+}
+
+func TestCallSetForbid(t *testing.T) {
+	var receiver interface{} = "TestReceiver"
+	var other interface{} = "OtherReceiver"
+	method := "TestMethod"
+	methodType := reflect.TypeOf(receiverType{}.Func)
+
+	cs := newCallSet()
+	cs.Add(newCall(t, receiver, method, methodType).AnyTimes())
+	cs.Add(newCall(t, other, method, methodType).AnyTimes())
+	cs.Forbid(receiver, "here")
+
+	if _, err := cs.FindMatch(receiver, method, nil); err == nil {
+		t.Error("expected error for forbidden receiver, but was nil")
+	}
+	if _, err := cs.FindMatch(other, method, nil); err != nil {
+		t.Errorf("FindMatch for other receiver: %v", err)
+	}
+}
+
+func TestCallSetRetire(t *testing.T) {
+	var receiver interface{} = "TestReceiver"
+	var other interface{} = "OtherReceiver"
+	methodType := reflect.TypeOf(receiverType{}.Func)
+
+	cs := newCallSet()
+	unsatisfied := newCall(t, receiver, "A", methodType)
+	cs.Add(unsatisfied)
+	cs.Add(newCall(t, receiver, "B", methodType).AnyTimes())
+	cs.Add(newCall(t, other, "A", methodType))
+
+	failures := cs.Retire(receiver)
+	if len(failures) != 1 || failures[0] != unsatisfied {
+		t.Errorf("Retire() = %v, want [%v]", failures, unsatisfied)
+	}
+	if failures := cs.Failures(); len(failures) != 1 || failures[0].receiver != other {
+		t.Errorf("Failures() = %v, want only the call on the other receiver", failures)
+	}
+}
@@ -17,9 +17,13 @@ package gomock
 import (
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	"runtime"
+	"runtime/debug"
+	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // A TestReporter is something that can be used to report test failures.  It
@@ -79,6 +83,17 @@ type Controller struct {
 	mu            sync.Mutex
 	expectedCalls *callSet
 	finished      bool
+	defaults      defaultValues
+	formatters    formatters
+	fast          atomic.Value // fastCalls
+
+	lateCallHandler  func(*LateCall)
+	failNextTestLate bool
+	stackTraces      bool
+
+	deepStubs bool
+	deepMocks map[deepStubKey]interface{} // nested mocks returned by deep stubs
+	lenient   map[interface{}]bool        // receivers that accept unexpected calls
 }
 
 // NewController returns a new Controller. It is the preferred way to create a
@@ -86,7 +101,7 @@ type Controller struct {
 //
 // New in go1.14+, if you are passing a *testing.T into this function you no
 // longer need to call ctrl.Finish() in your test methods.
-func NewController(t TestReporter) *Controller {
+func NewController(t TestReporter, opts ...ControllerOption) *Controller {
 	h, ok := t.(TestHelper)
 	if !ok {
 		h = &nopTestHelper{t}
@@ -94,6 +109,17 @@ func NewController(t TestReporter) *Controller {
 	ctrl := &Controller{
 		T:             h,
 		expectedCalls: newCallSet(),
+		formatters:    formatters{parent: &globalFormatters},
+	}
+	for _, opt := range opts {
+		opt.apply(ctrl)
+	}
+	if late := takePendingLateCalls(); len(late) > 0 {
+		ctrl.T.Helper()
+		for _, call := range late {
+			ctrl.T.Errorf("%v", call)
+		}
+		ctrl.T.Errorf("failing test due to %d call(s) made after an earlier test finished", len(late))
 	}
 	if c, ok := isCleanuper(ctrl.T); ok {
 		c.Cleanup(func() {
@@ -105,6 +131,52 @@ func NewController(t TestReporter) *Controller {
 	return ctrl
 }
 
+// ControllerOption configures how a Controller should behave.
+type ControllerOption interface {
+	apply(*Controller)
+}
+
+type lateCallHandlerOption func(*LateCall)
+
+func (o lateCallHandlerOption) apply(ctrl *Controller) {
+	ctrl.lateCallHandler = o
+}
+
+// WithLateCallHandler sets the function that is called with calls to mocks
+// made after the Controller finished, once its TestReporter can no longer be
+// used to report them because the test has completed, which the testing
+// package signals by panicking. By default such calls are printed to standard
+// error.
+func WithLateCallHandler(h func(*LateCall)) ControllerOption {
+	return lateCallHandlerOption(h)
+}
+
+type failNextTestOption struct{}
+
+func (failNextTestOption) apply(ctrl *Controller) {
+	ctrl.failNextTestLate = true
+}
+
+// WithLateCallsFailingNextTest makes calls to mocks that are made after the
+// test has completed fail the next Controller created in the same package,
+// which usually belongs to the next test that runs.
+func WithLateCallsFailingNextTest() ControllerOption {
+	return failNextTestOption{}
+}
+
+type stackTraceOption struct{}
+
+func (stackTraceOption) apply(ctrl *Controller) {
+	ctrl.stackTraces = true
+}
+
+// WithStackTraces makes failures for unexpected calls include the ID and the
+// stack trace of the goroutine that made the call, which helps locating calls
+// that come from deep inside the code under test or from other goroutines.
+func WithStackTraces() ControllerOption {
+	return stackTraceOption{}
+}
+
 type cancelReporter struct {
 	t      TestHelper
 	cancel func()
@@ -124,14 +196,14 @@ func (r *cancelReporter) Helper() {
 
 // WithContext returns a new Controller and a Context, which is cancelled on any
 // fatal failure.
-func WithContext(ctx context.Context, t TestReporter) (*Controller, context.Context) {
+func WithContext(ctx context.Context, t TestReporter, opts ...ControllerOption) (*Controller, context.Context) {
 	h, ok := t.(TestHelper)
 	if !ok {
 		h = &nopTestHelper{t: t}
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
-	return NewController(&cancelReporter{t: h, cancel: cancel}), ctx
+	return NewController(&cancelReporter{t: h, cancel: cancel}, opts...), ctx
 }
 
 type nopTestHelper struct {
@@ -147,6 +219,27 @@ func (h *nopTestHelper) Fatalf(format string, args ...interface{}) {
 
 func (h nopTestHelper) Helper() {}
 
+// SetDefault registers the value that calls return by default for results of
+// type typ, that is when no Return or DoAndReturn action of the matching call
+// provides one, instead of the zero value. It is commonly used for interface
+// results such as error or io.ReadCloser, for which the zero value is nil.
+//
+// value is either assignable to typ, or a function without arguments that
+// returns a value assignable to typ. A function is called every time a default
+// is needed, so each call gets a fresh value.
+//
+//   ctrl.SetDefault(reflect.TypeOf((*error)(nil)).Elem(), errNotImplemented)
+//   ctrl.SetDefault(reflect.TypeOf((*io.ReadCloser)(nil)).Elem(), func() io.ReadCloser {
+//     return io.NopCloser(strings.NewReader(""))
+//   })
+func (ctrl *Controller) SetDefault(typ reflect.Type, value interface{}) {
+	ctrl.T.Helper()
+
+	if err := ctrl.defaults.set(typ, value); err != nil {
+		ctrl.T.Fatalf("gomock: SetDefault: %v", err)
+	}
+}
+
 // RecordCall is called by a mock. It should not be called by user code.
 func (ctrl *Controller) RecordCall(receiver interface{}, method string, args ...interface{}) *Call {
 	ctrl.T.Helper()
@@ -166,10 +259,49 @@ func (ctrl *Controller) RecordCallWithMethodType(receiver interface{}, method st
 	ctrl.T.Helper()
 
 	call := newCall(ctrl.T, receiver, method, methodType, args...)
+	call.defaults = &ctrl.defaults
+	call.formatters = &ctrl.formatters
 
 	ctrl.mu.Lock()
 	defer ctrl.mu.Unlock()
 	ctrl.expectedCalls.Add(call)
+	ctrl.resetFastPath()
+
+	return call
+}
+
+// RecordStub is called by a mock. It should not be called by user code.
+func (ctrl *Controller) RecordStub(receiver interface{}, method string, args ...interface{}) *Call {
+	ctrl.T.Helper()
+
+	recv := reflect.ValueOf(receiver)
+	for i := 0; i < recv.Type().NumMethod(); i++ {
+		if recv.Type().Method(i).Name == method {
+			return ctrl.RecordStubWithMethodType(receiver, method, recv.Method(i).Type(), args...)
+		}
+	}
+	ctrl.T.Fatalf("gomock: failed finding method %s on %T", method, receiver)
+	panic("unreachable")
+}
+
+// RecordStubWithMethodType is called by a mock. It should not be called by user code.
+//
+// A stub defines the default behavior of calls that match it but none of the
+// expected calls, including those that have been exhausted. Stubs may be called any number of times and are never
+// reported as missing.
+func (ctrl *Controller) RecordStubWithMethodType(receiver interface{}, method string, methodType reflect.Type, args ...interface{}) *Call {
+	ctrl.T.Helper()
+
+	call := newCall(ctrl.T, receiver, method, methodType, args...)
+	call.defaults = &ctrl.defaults
+	call.formatters = &ctrl.formatters
+	call.stub = true
+	call.AnyTimes()
+
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+	ctrl.expectedCalls.AddStub(call)
+	ctrl.resetFastPath()
 
 	return call
 }
@@ -178,19 +310,61 @@ func (ctrl *Controller) RecordCallWithMethodType(receiver interface{}, method st
 func (ctrl *Controller) Call(receiver interface{}, method string, args ...interface{}) []interface{} {
 	ctrl.T.Helper()
 
+	// The readers in args are read at most once by stream matchers.
+	streams := ctrl.openStreams(receiver, method, args)
+	defer streams.close()
+
+	if actions, args, ok := ctrl.fastCall(receiver, method, args); ok {
+		return ctrl.callActions(actions, args)
+	}
+
+	var late *LateCall
+	var lateRets []interface{}
+	var unexpected error
+
 	// Nest this code so we can use defer to make sure the lock is released.
 	actions := func() []func([]interface{}) []interface{} {
-		ctrl.T.Helper()
 		ctrl.mu.Lock()
 		defer ctrl.mu.Unlock()
 
 		expected, err := ctrl.expectedCalls.FindMatch(receiver, method, args)
+		if expected != nil {
+			args = replayStreams(expected.methodType, args)
+		} else {
+			args = replayStreams(ctrl.methodType(receiver, method), args)
+		}
+		if ctrl.finished {
+			// The call neither fails nor runs actions through the normal path,
+			// since the test it belongs to may have already completed.
+			late = &LateCall{
+				Receiver:   receiver,
+				Method:     method,
+				Args:       args,
+				Origin:     callerInfo(3),
+				Stack:      string(debug.Stack()),
+				err:        err,
+				formatters: &ctrl.formatters,
+			}
+			lateRets = ctrl.defaultReturns(receiver, method)
+			return nil
+		}
+		if err != nil && ctrl.deepStubs && !ctrl.expectedCalls.Forbidden(receiver) {
+			if rets, ok := ctrl.deepStubReturns(receiver, method); ok {
+				return []func([]interface{}) []interface{}{func([]interface{}) []interface{} {
+					return rets
+				}}
+			}
+		}
 		if err != nil {
-			// callerInfo's skip should be updated if the number of calls between the user's test
-			// and this line changes, i.e. this code is wrapped in another anonymous function.
-			// 0 is us, 1 is controller.Call(), 2 is the generated mock, and 3 is the user's test.
-			origin := callerInfo(3)
-			ctrl.T.Fatalf("Unexpected call to %T.%v(%v) at %s because: %s", receiver, method, args, origin, err)
+			unexpected = err
+			return nil
+		}
+
+		if expected.fast() {
+			ctrl.updateFastPath(callSetKey{receiver, method})
+		}
+		if expected.stub {
+			return expected.call()
 		}
 
 		// Two things happen here:
@@ -205,19 +379,159 @@ func (ctrl *Controller) Call(receiver interface{}, method string, args ...interf
 		if expected.exhausted() {
 			ctrl.expectedCalls.Remove(expected)
 		}
+		if len(preReqCalls) != 0 || expected.exhausted() {
+			ctrl.resetFastPath()
+		}
 		return actions
 	}()
 
+	if late != nil {
+		ctrl.reportLateCall(late)
+		return lateRets
+	}
+	if unexpected != nil {
+		// callerInfo's skip should be updated if the number of calls between the user's test
+		// and this line changes, i.e. this code is wrapped in another anonymous function.
+		// 0 is us, 1 is the generated mock, and 2 is the user's test.
+		origin := callerInfo(2)
+		if ctrl.stackTraces {
+			ctrl.T.Fatalf("Unexpected call to %T.%v(%s) at %s because: %s\n%s", receiver, method, ctrl.formatters.formatArgs(args), origin, unexpected, callStack())
+		} else {
+			ctrl.T.Fatalf("Unexpected call to %T.%v(%s) at %s because: %s", receiver, method, ctrl.formatters.formatArgs(args), origin, unexpected)
+		}
+		return nil
+	}
+
+	return ctrl.callActions(actions, args)
+}
+
+// callActions runs the actions of a call, and returns the results of the last
+// one that returned any.
+func (ctrl *Controller) callActions(actions []func([]interface{}) []interface{}, args []interface{}) []interface{} {
+	ctrl.T.Helper()
+
 	var rets []interface{}
 	for _, action := range actions {
 		if r := action(args); r != nil {
 			rets = r
 		}
 	}
-
 	return rets
 }
 
+// reportLateCall reports a call made after the controller finished. It fails
+// the test as long as its TestReporter can be used, and afterwards hands the
+// call to the controller's late call handler. Late calls usually come from other
+// goroutines than the test's, so they are reported with Errorf rather than
+// Fatalf, which would stop the goroutine of the code under test.
+func (ctrl *Controller) reportLateCall(late *LateCall) {
+	ctrl.T.Helper()
+
+	if ctrl.tryErrorf("%v", late) {
+		return
+	}
+
+	if ctrl.failNextTestLate {
+		addPendingLateCall(late)
+	}
+	if ctrl.lateCallHandler != nil {
+		ctrl.lateCallHandler(late)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "gomock: %v\n", late)
+}
+
+// tryErrorf reports a failure with ctrl.T.Errorf, and returns false if the test
+// has already completed, in which case the testing package panics rather than
+// report it.
+func (ctrl *Controller) tryErrorf(format string, args ...interface{}) (reported bool) {
+	ctrl.T.Helper()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if msg, ok := r.(string); !ok || !isCompletedPanic(msg) {
+				panic(r)
+			}
+			reported = false
+		}
+	}()
+	ctrl.T.Errorf(format, args...)
+	return true
+}
+
+// isCompletedPanic reports whether msg is the message of the panic of the
+// testing package when a test is used after it has completed, such as "Log in
+// goroutine after TestFoo has completed: ...".
+func isCompletedPanic(msg string) bool {
+	i := strings.Index(msg, " in goroutine after ")
+	return i >= 0 && strings.Contains(msg[i:], " has completed")
+}
+
+// defaultReturns returns the default values for the results of the given
+// method, or nil if its type can't be determined.
+func (ctrl *Controller) defaultReturns(receiver interface{}, method string) []interface{} {
+	methodType := ctrl.methodType(receiver, method)
+	if methodType == nil {
+		return nil
+	}
+	return ctrl.defaults.returns(methodType)
+}
+
+// methodType returns the type of the given method, using the method type of
+// any call recorded for it, since unexported methods can't be looked up.
+func (ctrl *Controller) methodType(receiver interface{}, method string) reflect.Type {
+	key := callSetKey{receiver, method}
+	for _, calls := range [][]*Call{ctrl.expectedCalls.expected[key], ctrl.expectedCalls.exhausted[key], ctrl.expectedCalls.stubs[key]} {
+		if len(calls) > 0 {
+			return calls[0].methodType
+		}
+	}
+	m := reflect.ValueOf(receiver).MethodByName(method)
+	if !m.IsValid() {
+		return nil
+	}
+	return m.Type()
+}
+
+// Forbid makes every later call on the given mocks fail immediately.
+func (ctrl *Controller) Forbid(mocks ...interface{}) {
+	ctrl.T.Helper()
+
+	origin := callerInfo(1)
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+	for _, m := range mocks {
+		ctrl.expectedCalls.Forbid(m, origin)
+	}
+	ctrl.resetFastPath()
+}
+
+// VerifyNoMoreInteractions checks that all the methods that were expected to
+// be called on the given mocks were called, and then makes every later call on
+// them fail immediately, like Forbid.
+func (ctrl *Controller) VerifyNoMoreInteractions(mocks ...interface{}) {
+	ctrl.T.Helper()
+
+	origin := callerInfo(1)
+	var failures []*Call
+	func() {
+		ctrl.mu.Lock()
+		defer ctrl.mu.Unlock()
+		for _, m := range mocks {
+			failures = append(failures, ctrl.expectedCalls.Retire(m)...)
+			ctrl.expectedCalls.Forbid(m, origin)
+		}
+		ctrl.resetFastPath()
+	}()
+
+	for _, call := range failures {
+		ctrl.T.Errorf("missing call(s) to %v", call)
+	}
+	if len(failures) != 0 {
+		ctrl.T.Fatalf("aborting test due to missing call(s) at %s", origin)
+	}
+}
+
 // Finish checks to see if all the methods that were expected to be called
 // were called. It should be invoked for each Controller. It is not idempotent
 // and therefore can only be invoked once.
@@ -244,6 +558,7 @@ func (ctrl *Controller) finish(cleanup bool, panicErr interface{}) {
 		return
 	}
 	ctrl.finished = true
+	ctrl.resetFastPath()
 
 	// Short-circuit, pass through the panic.
 	if panicErr != nil {
@@ -266,11 +581,53 @@ func (ctrl *Controller) finish(cleanup bool, panicErr interface{}) {
 
 // callerInfo returns the file:line of the call site. skip is the number
 // of stack frames to skip when reporting. 0 is callerInfo's call site.
+// Frames of functions marked with Helper are skipped as well.
 func callerInfo(skip int) string {
-	if _, file, line, ok := runtime.Caller(skip + 1); ok {
-		return fmt.Sprintf("%s:%d", file, line)
+	return frameInfo(callers(skip + 1))
+}
+
+// callers returns the stack of the call site, to be resolved with frameInfo
+// only when needed. skip is the number of stack frames to skip. 0 is
+// callers' call site.
+func callers(skip int) []uintptr {
+	pcs := make([]uintptr, 32)
+	return pcs[:runtime.Callers(skip+2, pcs)]
+}
+
+// frameInfo returns the file:line of the first frame of pcs that is not of a
+// function marked with Helper.
+func frameInfo(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return "unknown file"
+	}
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if _, ok := helpers.Load(frame.Function); !ok || !more {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+	}
+}
+
+// helpers holds the names of functions marked with Helper.
+var helpers sync.Map
+
+// Helper marks the calling function as a test helper function. When gomock
+// reports where an expectation was set or where a mock was called, the frames
+// of helper functions are skipped, the same way t.Helper does for test output.
+// It is meant for functions that wrap EXPECT calls:
+//
+//   func expectLogin(m *MockAuth, user string) *gomock.Call {
+//     gomock.Helper()
+//     return m.EXPECT().Login(user, gomock.Any()).Return(nil)
+//   }
+func Helper() {
+	var pc [1]uintptr
+	if runtime.Callers(2, pc[:]) == 0 {
+		return
 	}
-	return "unknown file"
+	frame, _ := runtime.CallersFrames(pc[:]).Next()
+	helpers.Store(frame.Function, struct{}{})
 }
 
 // isCleanuper checks it if t's base TestReporter has a Cleanup method.
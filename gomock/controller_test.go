@@ -15,8 +15,13 @@
 package gomock_test
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"reflect"
+	"runtime"
+	"sync"
 	"testing"
 
 	"strings"
@@ -135,6 +140,49 @@ func (h *HelperReporter) Helper() {
 	h.helper++
 }
 
+// LocationReporter records where the last failure was reported from,
+// skipping the functions that called Helper like *testing.T does.
+type LocationReporter struct {
+	*ErrorReporter
+	helpers  map[string]bool
+	location string
+}
+
+func NewLocationReporter(t *testing.T) *LocationReporter {
+	return &LocationReporter{ErrorReporter: NewErrorReporter(t), helpers: make(map[string]bool)}
+}
+
+func (r *LocationReporter) Helper() {
+	pc, _, _, _ := runtime.Caller(1)
+	r.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+func (r *LocationReporter) Errorf(format string, args ...interface{}) {
+	r.location = r.caller()
+	r.ErrorReporter.Errorf(format, args...)
+}
+
+func (r *LocationReporter) Fatalf(format string, args ...interface{}) {
+	r.location = r.caller()
+	r.ErrorReporter.Fatalf(format, args...)
+}
+
+// caller returns the file and line of the first caller of Errorf or Fatalf
+// that is not a helper.
+func (r *LocationReporter) caller() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !r.helpers[frame.Function] {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
 // A type purely for use as a receiver in testing the Controller.
 type Subject struct{}
 
@@ -148,6 +196,35 @@ func (s *Subject) BarMethod(arg string) int {
 
 func (s *Subject) VariadicMethod(arg int, vararg ...string) {}
 
+func (s *Subject) ErrorMethod(arg string) (int, error) {
+	return 0, nil
+}
+
+func (s *Subject) ChildMethod(arg string) (Child, error) {
+	return nil, nil
+}
+
+type Child interface {
+	Name() string
+}
+
+// ChildMock is a hand-written mock of Child, as mockgen would generate it.
+type ChildMock struct {
+	ctrl *gomock.Controller
+}
+
+func (m *ChildMock) Name() string {
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+func init() {
+	gomock.RegisterMockConstructor(reflect.TypeOf((*Child)(nil)).Elem(), func(ctrl *gomock.Controller) interface{} {
+		return &ChildMock{ctrl: ctrl}
+	})
+}
+
 // A type purely for ActOnTestStructMethod
 type TestStruct struct {
 	Number  int
@@ -161,6 +238,16 @@ func (s *Subject) ActOnTestStructMethod(arg TestStruct, arg1 int) int {
 func (s *Subject) SetArgMethod(sliceArg []byte, ptrArg *int, mapArg map[interface{}]interface{}) {}
 func (s *Subject) SetArgMethodInterface(sliceArg, ptrArg, mapArg interface{})                    {}
 
+func (s *Subject) UploadMethod(name string, r io.Reader) error {
+	return nil
+}
+
+type Names []string
+
+func (s *Subject) NamesMethod() Names {
+	return nil
+}
+
 func assertEqual(t *testing.T, expected interface{}, actual interface{}) {
 	if !reflect.DeepEqual(expected, actual) {
 		t.Errorf("Expected %+v, but got %+v", expected, actual)
@@ -265,6 +352,45 @@ func TestUnexpectedArgCount(t *testing.T) {
 	})
 }
 
+func TestRecordCallValidatesArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		args    []interface{}
+		wantErr []string
+	}{
+		{"too many", "FooMethod", []interface{}{"a", "b"}, []string{"wrong number of arguments: got 2, want 1"}},
+		{"too few", "FooMethod", nil, []string{"wrong number of arguments: got 0, want 1"}},
+		{"wrong type", "FooMethod", []interface{}{1}, []string{"wrong type of argument 0: int is not assignable to string"}},
+		{"nil for non-nillable", "FooMethod", []interface{}{nil}, []string{"argument 0 is nil, but string is not nillable"}},
+		{"too few variadic", "VariadicMethod", nil, []string{"got 0, want at least 1"}},
+		{"wrong variadic type", "VariadicMethod", []interface{}{0, "1", 2}, []string{"wrong type of argument 2: int is not assignable to string"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter, ctrl := createFixtures(t)
+			reporter.assertFatal(func() {
+				ctrl.RecordCall(new(Subject), tt.method, tt.args...)
+			}, append([]string{"invalid expected call of *gomock_test.Subject." + tt.method, "controller_test.go"}, tt.wantErr...)...)
+		})
+	}
+}
+
+func TestRecordCallAcceptsValidArgs(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	s := new(Subject)
+
+	ctrl.RecordCall(s, "FooMethod", gomock.Any()).AnyTimes()
+	ctrl.RecordCall(s, "VariadicMethod", 0).AnyTimes()
+	ctrl.RecordCall(s, "VariadicMethod", 0, "1", "2").AnyTimes()
+	ctrl.RecordCall(s, "VariadicMethod", 0, []string{"1", "2"}).AnyTimes()
+	ctrl.RecordCall(s, "SetArgMethod", []byte{}, nil, nil).AnyTimes()
+	ctrl.RecordCall(s, "SetArgMethodInterface", 1, "2", nil).AnyTimes()
+	ctrl.Finish()
+	reporter.assertPass("valid expected calls")
+}
+
 // This tests that a call with complex arguments (a struct and some primitive type) matches a recorded call.
 func TestExpectedMethodCall_CustomStruct(t *testing.T) {
 	reporter, ctrl := createFixtures(t)
@@ -379,6 +505,98 @@ func TestUnexpectedArgValue_GotFormatter(t *testing.T) {
 	})
 }
 
+func TestUnexpectedArgValue_RegisterFormatter(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	ctrl.RegisterFormatter(reflect.TypeOf(TestStruct{}), func(x interface{}) string {
+		return fmt.Sprintf("#%d", x.(TestStruct).Number)
+	})
+	ctrl.RecordCall(subject, "ActOnTestStructMethod", TestStruct{Number: 123, Message: "hello"}, 15)
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "ActOnTestStructMethod", TestStruct{Number: 456, Message: "hello"}, 15)
+	}, "Unexpected call to", "ActOnTestStructMethod([#456 15])", "doesn't match the argument at index 0",
+		"Got: #456 (gomock_test.TestStruct)\nWant: is equal to #123 (gomock_test.TestStruct)")
+
+	if got, want := ctrl.Expectations()[0].Args[0], "is equal to #123 (gomock_test.TestStruct)"; got != want {
+		t.Errorf("Expectations()[0].Args[0] = %q, want %q", got, want)
+	}
+
+	reporter.assertFatal(func() {
+		// The expected call wasn't made.
+		ctrl.Finish()
+	})
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	type token string
+	gomock.RegisterFormatter(reflect.TypeOf(token("")), func(x interface{}) string {
+		return "<redacted>"
+	})
+
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "SetArgMethodInterface", token("secret"), nil, nil).AnyTimes()
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "SetArgMethodInterface", token("other"), nil, nil)
+	}, "SetArgMethodInterface([<redacted> <nil> <nil>])",
+		"Got: <redacted> (gomock_test.token)\nWant: is equal to <redacted> (gomock_test.token)")
+
+	// Controller formatters take precedence over global ones.
+	ctrl.RegisterFormatter(reflect.TypeOf(token("")), func(x interface{}) string {
+		return "<token>"
+	})
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "SetArgMethodInterface", token("other"), nil, nil)
+	}, "SetArgMethodInterface([<token> <nil> <nil>])", "Want: is equal to <token> (gomock_test.token)")
+}
+
+type redacted interface {
+	redacted()
+}
+
+func TestRegisterFormatter_InterfaceAndNil(t *testing.T) {
+	gomock.RegisterFormatter(reflect.TypeOf((*redacted)(nil)).Elem(), func(x interface{}) string {
+		return "<redacted>"
+	})
+	if got, want := gomock.Eq(nil).String(), "is equal to <nil> (<nil>)"; got != want {
+		t.Errorf("Eq(nil).String() = %q, want %q", got, want)
+	}
+
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	ctrl.RegisterFormatter(reflect.TypeOf((*fmt.Stringer)(nil)).Elem(), func(x interface{}) string {
+		return "<stringer>"
+	})
+	ctrl.RecordCall(subject, "SetArgMethodInterface", "secret", nil, nil).AnyTimes()
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "SetArgMethodInterface", nil, nil, nil)
+	}, "SetArgMethodInterface([<nil> <nil> <nil>])", "Got: <nil> (<nil>)")
+}
+
+func TestUnexpectedArgValue_Truncated(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	long := strings.Repeat("a", 2000)
+	ctrl.RecordCall(subject, "FooMethod", long)
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "b"+long)
+	}, "... (977 more bytes) (string)\nWant: is equal to "+long[:1024]+"... (976 more bytes) (string)")
+
+	reporter.assertFatal(func() {
+		// The expected call wasn't made.
+		ctrl.Finish()
+	})
+}
+
 func TestAnyTimes(t *testing.T) {
 	reporter, ctrl := createFixtures(t)
 	subject := new(Subject)
@@ -865,9 +1083,15 @@ func TestDeferNotNeededFail(t *testing.T) {
 	subject := new(Subject)
 	var ctrl *gomock.Controller
 	reporter.Cleanup(func() {
-		reporter.assertFatal(func() {
-			ctrl.Call(subject, "NotRecordedMethod", "argument")
-		}, "Unexpected call to", "there are no expected calls of the method \"NotRecordedMethod\" for that receiver")
+		// This cleanup runs after that of the controller, but the test can
+		// still be failed.
+		ctrl.Call(subject, "NotRecordedMethod", "argument")
+		reporter.assertFail("call made after the controller finished")
+		for _, want := range []string{"Unexpected call to", "there are no expected calls of the method \"NotRecordedMethod\" for that receiver"} {
+			if msg := reporter.log[len(reporter.log)-1]; !strings.Contains(msg, want) {
+				t.Errorf("got error %q, want it to contain %q", msg, want)
+			}
+		}
 	})
 	ctrl = gomock.NewController(reporter)
 }
@@ -888,45 +1112,66 @@ func TestOrderedCallsInCorrect(t *testing.T) {
 	reporter := NewErrorReporter(t)
 	subjectOne := new(Subject)
 	subjectTwo := new(Subject)
-	var ctrl *gomock.Controller
-	reporter.Cleanup(func() {
-		reporter.assertFatal(func() {
-			gomock.InOrder(
-				ctrl.RecordCall(subjectOne, "FooMethod", "1").AnyTimes(),
-				ctrl.RecordCall(subjectTwo, "FooMethod", "2"),
-				ctrl.RecordCall(subjectTwo, "BarMethod", "3"),
-			)
-			ctrl.Call(subjectOne, "FooMethod", "1")
-			// FooMethod(2) should be called before BarMethod(3)
-			ctrl.Call(subjectTwo, "BarMethod", "3")
-		}, "Unexpected call to", "Subject.BarMethod([3])", "doesn't have a prerequisite call satisfied")
-	})
-	ctrl = gomock.NewController(reporter)
+	ctrl := gomock.NewController(reporter)
+	reporter.assertFatal(func() {
+		gomock.InOrder(
+			ctrl.RecordCall(subjectOne, "FooMethod", "1").AnyTimes(),
+			ctrl.RecordCall(subjectTwo, "FooMethod", "2"),
+			ctrl.RecordCall(subjectTwo, "BarMethod", "3"),
+		)
+		ctrl.Call(subjectOne, "FooMethod", "1")
+		// FooMethod(2) should be called before BarMethod(3)
+		ctrl.Call(subjectTwo, "BarMethod", "3")
+	}, "Unexpected call to", "Subject.BarMethod([3])", "doesn't have a prerequisite call satisfied")
 }
 
 // Test that calls that are prerequisites to other calls but have maxCalls >
 // minCalls are removed from the expected call set.
 func TestOrderedCallsWithPreReqMaxUnbounded(t *testing.T) {
-	reporter := NewErrorReporter(t)
+	reporter, ctrl := createFixtures(t)
 	subjectOne := new(Subject)
 	subjectTwo := new(Subject)
-	var ctrl *gomock.Controller
-	reporter.Cleanup(func() {
-		reporter.assertFatal(func() {
-			// Initially we should be able to call FooMethod("1") as many times as we
-			// want.
-			ctrl.Call(subjectOne, "FooMethod", "1")
-			ctrl.Call(subjectOne, "FooMethod", "1")
 
-			// But calling something that has it as a prerequite should remove it from
-			// the expected call set. This allows tests to ensure that FooMethod("1") is
-			// *not* called after FooMethod("2").
-			ctrl.Call(subjectTwo, "FooMethod", "2")
+	gomock.InOrder(
+		ctrl.RecordCall(subjectOne, "FooMethod", "1").AnyTimes(),
+		ctrl.RecordCall(subjectTwo, "FooMethod", "2"),
+	)
 
-			ctrl.Call(subjectOne, "FooMethod", "1")
-		})
-	})
-	ctrl = gomock.NewController(reporter)
+	// Initially we should be able to call FooMethod("1") as many times as we
+	// want.
+	ctrl.Call(subjectOne, "FooMethod", "1")
+	ctrl.Call(subjectOne, "FooMethod", "1")
+
+	// But calling something that has it as a prerequite should remove it from
+	// the expected call set. This allows tests to ensure that FooMethod("1") is
+	// *not* called after FooMethod("2").
+	ctrl.Call(subjectTwo, "FooMethod", "2")
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subjectOne, "FooMethod", "1")
+	}, "Unexpected call to", "have been exhausted")
+	ctrl.Finish()
+}
+
+// Test that every call made after the test completed goes to the late call
+// handler.
+func TestLateCallsAfterTestCompleted(t *testing.T) {
+	reporter := &CompletedReporter{}
+	subjectOne := new(Subject)
+	subjectTwo := new(Subject)
+	var late int
+	ctrl := gomock.NewController(reporter, gomock.WithLateCallHandler(func(*gomock.LateCall) {
+		late++
+	}))
+	reporter.complete()
+
+	ctrl.Call(subjectOne, "FooMethod", "1")
+	ctrl.Call(subjectOne, "FooMethod", "1")
+	ctrl.Call(subjectTwo, "FooMethod", "2")
+	ctrl.Call(subjectOne, "FooMethod", "1")
+	if late != 4 {
+		t.Errorf("got %d late calls, want 4", late)
+	}
 }
 
 func TestCallAfterLoopPanic(t *testing.T) {
@@ -952,3 +1197,819 @@ func TestCallAfterLoopPanic(t *testing.T) {
 	})
 	ctrl = gomock.NewController(reporter)
 }
+
+func TestCallAfterFinish(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", "argument").AnyTimes()
+	ctrl.Call(subject, "FooMethod", "argument")
+	ctrl.Finish()
+	reporter.assertPass("call made before Finish")
+
+	// The call is reported with Errorf, since it usually comes from another
+	// goroutine.
+	ctrl.Call(subject, "FooMethod", "argument")
+	reporter.assertFail("call made after Finish")
+	for _, want := range []string{"Unexpected call to *gomock_test.Subject.FooMethod([argument])", "the controller has already finished", "goroutine"} {
+		if msg := reporter.log[len(reporter.log)-1]; !strings.Contains(msg, want) {
+			t.Errorf("got error %q, want it to contain %q", msg, want)
+		}
+	}
+}
+
+// CompletedReporter behaves like a *testing.T whose test completes when
+// complete is called.
+type CompletedReporter struct {
+	cleanups  []func()
+	completed bool
+}
+
+func (r *CompletedReporter) Errorf(format string, args ...interface{}) {
+	if r.completed {
+		panic("Log in goroutine after TestFoo has completed: " + fmt.Sprintf(format, args...))
+	}
+}
+
+func (r *CompletedReporter) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+}
+
+func (*CompletedReporter) Helper() {}
+
+func (r *CompletedReporter) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+func (r *CompletedReporter) complete() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+	r.completed = true
+}
+
+func TestCallAfterTestCompleted(t *testing.T) {
+	var late []*gomock.LateCall
+	reporter := &CompletedReporter{}
+	ctrl := gomock.NewController(reporter, gomock.WithLateCallHandler(func(c *gomock.LateCall) {
+		late = append(late, c)
+	}))
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").Return(7).AnyTimes()
+	reporter.complete()
+
+	rets := ctrl.Call(subject, "FooMethod", "argument")
+
+	assertEqual(t, []interface{}{0}, rets)
+	if len(late) != 1 {
+		t.Fatalf("got %d late calls, want 1", len(late))
+	}
+	assertEqual(t, subject, late[0].Receiver)
+	assertEqual(t, "FooMethod", late[0].Method)
+	assertEqual(t, []interface{}{"argument"}, late[0].Args)
+	if !strings.Contains(late[0].Stack, "TestCallAfterTestCompleted") {
+		t.Errorf("stack does not contain the calling test:\n%s", late[0].Stack)
+	}
+}
+
+func TestCallAfterTestCompletedFailsNextTest(t *testing.T) {
+	completed := &CompletedReporter{}
+	ctrl := gomock.NewController(completed,
+		gomock.WithLateCallHandler(func(*gomock.LateCall) {}),
+		gomock.WithLateCallsFailingNextTest())
+	subject := new(Subject)
+	completed.complete()
+	ctrl.Call(subject, "FooMethod", "argument")
+
+	reporter, next := createFixtures(t)
+	reporter.assertFail("late call from an earlier test")
+	next.Finish()
+
+	reporter, _ = createFixtures(t)
+	reporter.assertPass("late calls are only reported once")
+}
+
+func TestUnexpectedCallStackTrace(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithStackTraces())
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+	ctrl.Call(subject, "FooMethod", "argument")
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "Unexpected call to", "max number of times", "\ngoroutine ", "gomock_test.TestUnexpectedCallStackTrace")
+
+	msg := reporter.log[len(reporter.log)-1]
+	for _, internal := range []string{"gomock.(*Controller)", "runtime.", "testing.tRunner"} {
+		if strings.Contains(msg, internal) {
+			t.Errorf("stack trace contains internal frame %q:\n%s", internal, msg)
+		}
+	}
+	ctrl.Finish()
+}
+
+func TestUnexpectedCallStackTraceFromGoroutine(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithStackTraces())
+	subject := new(Subject)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		reporter.assertFatal(func() {
+			ctrl.Call(subject, "FooMethod", "argument")
+		}, "there are no expected calls", "\ngoroutine ", "gomock_test.TestUnexpectedCallStackTraceFromGoroutine.func1")
+	}()
+	<-done
+	ctrl.Finish()
+}
+
+func expectFooMethod(ctrl *gomock.Controller, s *Subject, arg string) *gomock.Call {
+	gomock.Helper()
+	return ctrl.RecordCall(s, "FooMethod", arg)
+}
+
+func nestedExpectFooMethod(ctrl *gomock.Controller, s *Subject, arg string) *gomock.Call {
+	gomock.Helper()
+	return expectFooMethod(ctrl, s, arg)
+}
+
+func TestHelperOrigin(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	_, file, line, _ := runtime.Caller(0)
+	expectFooMethod(ctrl, subject, "direct")
+	nestedExpectFooMethod(ctrl, subject, "nested")
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "other")
+	}, fmt.Sprintf("expected call at %s:%d doesn't match", file, line+1),
+		fmt.Sprintf("expected call at %s:%d doesn't match", file, line+2))
+	ctrl.Call(subject, "FooMethod", "direct")
+	ctrl.Call(subject, "FooMethod", "nested")
+	ctrl.Finish()
+}
+
+func TestActionFailureLocation(t *testing.T) {
+	tests := []struct {
+		name   string
+		record func(*gomock.Call)
+		// fast is set for calls that take the fast path from their second
+		// call on.
+		fast bool
+	}{
+		{"wrong type of result", func(c *gomock.Call) {
+			c.DoAndReturn(func(string) string { return "" })
+		}, false},
+		{"wrong number of arguments", func(c *gomock.Call) {
+			c.DoAndReturn(func() int { return 0 })
+		}, false},
+		{"fast path", func(c *gomock.Call) {
+			c.DoAndReturn(func(arg string) interface{} {
+				if arg == "b" {
+					return "wrong"
+				}
+				return 0
+			}).AnyTimes()
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter := NewLocationReporter(t)
+			ctrl := gomock.NewController(reporter)
+			subject := new(Subject)
+			call := ctrl.RecordCall(subject, "FooMethod", gomock.Any())
+			tt.record(call)
+			if tt.fast {
+				ctrl.Call(subject, "FooMethod", "a")
+			}
+
+			var want string
+			reporter.assertFatal(func() {
+				_, file, line, _ := runtime.Caller(0)
+				want = fmt.Sprintf("%s:%d", file, line+2)
+				ctrl.Call(subject, "FooMethod", "b")
+			})
+			if reporter.location != want {
+				t.Errorf("failure reported at %s, want %s", reporter.location, want)
+			}
+		})
+	}
+}
+
+func TestStub(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	ctrl.RecordStub(subject, "FooMethod", gomock.Any()).Return(1)
+	ctrl.RecordCall(subject, "FooMethod", "expected").Return(2)
+
+	assertEqual(t, []interface{}{1}, ctrl.Call(subject, "FooMethod", "stubbed"))
+	assertEqual(t, []interface{}{2}, ctrl.Call(subject, "FooMethod", "expected"))
+	ctrl.Finish()
+	reporter.assertPass("stubs with matching calls")
+}
+
+func TestStubExhaustedCall(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordStub(subject, "FooMethod", gomock.Any()).Return(0)
+	ctrl.RecordCall(subject, "FooMethod", "1").Return(1).Times(1)
+
+	assertEqual(t, []interface{}{1}, ctrl.Call(subject, "FooMethod", "1"))
+	// The stub doesn't hide calls beyond the limit of the expected call.
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "1")
+	}, "Unexpected call to", "has already been called the max number of times")
+	// Calls that match no expected call still use the stub.
+	assertEqual(t, []interface{}{0}, ctrl.Call(subject, "FooMethod", "2"))
+	ctrl.Finish()
+}
+
+func TestStubNotCalled(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordStub(subject, "FooMethod", gomock.Any()).Return(1)
+	ctrl.Finish()
+	reporter.assertPass("stubs are not verified")
+}
+
+func TestStubNoMatch(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordStub(subject, "FooMethod", "stubbed")
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "other")
+	}, "Unexpected call to", "doesn't match the argument at index 0")
+	ctrl.Finish()
+}
+
+type testError string
+
+func (e testError) Error() string { return string(e) }
+
+func TestSetDefault(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	errType := reflect.TypeOf((*error)(nil)).Elem()
+	ctrl.SetDefault(errType, testError("not implemented"))
+	n := 0
+	ctrl.SetDefault(reflect.TypeOf(0), func() int {
+		n++
+		return n
+	})
+
+	ctrl.RecordCall(subject, "ErrorMethod", "a").Times(2)
+	ctrl.RecordCall(subject, "ErrorMethod", "b").Return(7, nil)
+
+	assertEqual(t, []interface{}{1, testError("not implemented")}, ctrl.Call(subject, "ErrorMethod", "a"))
+	assertEqual(t, []interface{}{2, testError("not implemented")}, ctrl.Call(subject, "ErrorMethod", "a"))
+	// Return overrides the defaults.
+	assertEqual(t, []interface{}{7, nil}, ctrl.Call(subject, "ErrorMethod", "b"))
+	ctrl.Finish()
+	reporter.assertPass("calls with default values")
+}
+
+func TestSetDefaultConvertsToType(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	type number int
+	ctrl.SetDefault(reflect.TypeOf(number(0)), number(5))
+	ctrl.SetDefault(reflect.TypeOf(0), 3)
+
+	ctrl.RecordCall(subject, "FooMethod", "a")
+	rets := ctrl.Call(subject, "FooMethod", "a")
+	if _, ok := rets[0].(int); !ok {
+		t.Errorf("got %T, want int", rets[0])
+	}
+	assertEqual(t, []interface{}{3}, rets)
+	ctrl.Finish()
+}
+
+func TestDefaultReturnsAreNotShared(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "ErrorMethod", "a").Times(2)
+	rets := ctrl.Call(subject, "ErrorMethod", "a")
+	rets[0] = 7
+	// Other calls still return zero values.
+	assertEqual(t, []interface{}{0, nil}, ctrl.Call(subject, "ErrorMethod", "a"))
+	ctrl.Finish()
+	reporter.assertPass("calls with zero values")
+}
+
+func TestSetDefaultInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		typ   reflect.Type
+		value interface{}
+	}{
+		{"wrong type", reflect.TypeOf(0), "zero"},
+		{"nil for non-nillable type", reflect.TypeOf(0), nil},
+		{"factory of wrong type", reflect.TypeOf(0), func() string { return "" }},
+		{"factory with arguments", reflect.TypeOf(0), func(int) int { return 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter, ctrl := createFixtures(t)
+			reporter.assertFatal(func() {
+				ctrl.SetDefault(tt.typ, tt.value)
+			}, "gomock: SetDefault: default for int")
+		})
+	}
+}
+
+func TestDeepStubs(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	defer reporter.recoverUnexpectedFatal()
+	ctrl := gomock.NewController(reporter, gomock.WithDeepStubs())
+	subject := new(Subject)
+
+	rets := ctrl.Call(subject, "ChildMethod", "a")
+	child, ok := rets[0].(*ChildMock)
+	if !ok {
+		t.Fatalf("got %T, want *ChildMock", rets[0])
+	}
+	assertEqual(t, nil, rets[1])
+
+	// The nested mock is reachable from the parent.
+	assertEqual(t, child, ctrl.Call(subject, "ChildMethod", "b")[0])
+
+	// The nested mock is lenient, but its expected calls are verified.
+	assertEqual(t, "", child.Name())
+	ctrl.RecordCall(child, "Name").Return("child")
+	assertEqual(t, "child", child.Name())
+	ctrl.RecordCall(child, "Name")
+	reporter.assertFatal(func() {
+		ctrl.Finish()
+	}, "aborting test due to missing call(s)")
+}
+
+func TestDeepStubsWithDefault(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	defer reporter.recoverUnexpectedFatal()
+	ctrl := gomock.NewController(reporter, gomock.WithDeepStubs())
+	subject := new(Subject)
+
+	child := &ChildMock{ctrl: ctrl}
+	ctrl.SetDefault(reflect.TypeOf((*Child)(nil)).Elem(), child)
+
+	assertEqual(t, child, ctrl.Call(subject, "ChildMethod", "a")[0])
+}
+
+func TestDeepStubsOtherResults(t *testing.T) {
+	reporter := NewErrorReporter(t)
+	ctrl := gomock.NewController(reporter, gomock.WithDeepStubs())
+	subject := new(Subject)
+
+	// The receiver isn't lenient, and the method has no mockable results.
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "a")
+	}, "Unexpected call to")
+}
+
+func TestNoDeepStubs(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "ChildMethod", "a")
+	}, "Unexpected call to")
+}
+
+func TestForbid(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	child := &ChildMock{ctrl: ctrl}
+
+	ctrl.RecordCall(subject, "FooMethod", "argument").AnyTimes()
+	ctrl.RecordCall(child, "Name").AnyTimes()
+	ctrl.Call(subject, "FooMethod", "argument")
+	ctrl.Forbid(subject)
+
+	ctrl.Call(child, "Name")
+	reporter.assertPass("calls on other receivers are allowed")
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}, "Unexpected call to", "no more calls to this receiver are allowed since", "controller_test.go")
+	ctrl.Finish()
+}
+
+func TestVerifyNoMoreInteractions(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+	ctrl.RecordCall(subject, "BarMethod", "argument").AnyTimes()
+	ctrl.Call(subject, "FooMethod", "argument")
+	ctrl.VerifyNoMoreInteractions(subject)
+	reporter.assertPass("all expected calls were made")
+
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "BarMethod", "argument")
+	}, "Unexpected call to", "no more calls to this receiver are allowed since")
+	ctrl.Finish()
+}
+
+func TestVerifyNoMoreInteractionsMissingCall(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", "argument")
+	reporter.assertFatal(func() {
+		ctrl.VerifyNoMoreInteractions(subject)
+	}, "aborting test due to missing call(s) at", "controller_test.go")
+
+	// The missing call is not reported again.
+	n := len(reporter.log)
+	ctrl.Finish()
+	if len(reporter.log) != n {
+		t.Errorf("missing call reported again: %v", reporter.log[n:])
+	}
+}
+
+func TestExpectations(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	first := ctrl.RecordCall(subject, "FooMethod", "1")
+	ctrl.RecordCall(subject, "BarMethod", gomock.Any()).MinTimes(2).After(first)
+	ctrl.RecordStub(subject, "FooMethod", gomock.Any())
+	if got := len(ctrl.Expectations()[1].PreReqs); got != 1 {
+		t.Errorf("got %d prerequisites before the call, want 1", got)
+	}
+	var before strings.Builder
+	if err := ctrl.Dump(&before); err != nil {
+		t.Fatalf("Dump: %v", err)
+	}
+	if want := ": 0 call(s) made, want at least 2; unsatisfied\n\tafter *gomock_test.Subject.FooMethod(is equal to 1 (string)) "; !strings.Contains(before.String(), want) {
+		t.Errorf("Dump output before the call:\n%s\nwant to contain: %q", before.String(), want)
+	}
+	ctrl.Call(subject, "FooMethod", "1")
+
+	exps := ctrl.Expectations()
+	if len(exps) != 3 {
+		t.Fatalf("got %d expectations, want 3", len(exps))
+	}
+
+	assertEqual(t, "FooMethod", exps[0].Method)
+	assertEqual(t, []string{"is equal to 1 (string)"}, exps[0].Args)
+	assertEqual(t, 1, exps[0].NumCalls)
+	assertEqual(t, true, exps[0].Satisfied)
+	assertEqual(t, true, exps[0].Exhausted)
+	if !strings.Contains(exps[0].Origin, "controller_test.go") {
+		t.Errorf("got origin %q, want it in controller_test.go", exps[0].Origin)
+	}
+
+	assertEqual(t, "BarMethod", exps[1].Method)
+	assertEqual(t, 2, exps[1].MinCalls)
+	assertEqual(t, 0, exps[1].NumCalls)
+	assertEqual(t, false, exps[1].Satisfied)
+	assertEqual(t, 0, len(exps[1].PreReqs)) // the prerequisite has been satisfied
+
+	assertEqual(t, true, exps[2].Stub)
+
+	var b strings.Builder
+	if err := ctrl.Dump(&b); err != nil {
+		t.Fatalf("Dump: %v", err)
+	}
+	for _, want := range []string{
+		"3 expectation(s):\n",
+		"*gomock_test.Subject.FooMethod(is equal to 1 (string)) ",
+		": 1 call(s) made, want 1; satisfied, exhausted\n",
+		"*gomock_test.Subject.BarMethod(is anything) ",
+		": 0 call(s) made, want at least 2; unsatisfied\n*gomock_test.Subject.FooMethod(is anything) ",
+		": 0 call(s) made, want any number; stub, satisfied\n",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("Dump output:\n%s\nwant to contain: %q", b.String(), want)
+		}
+	}
+}
+
+func TestReaderMatcherReplaysContent(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	var uploaded []string
+	upload := func(name string, r io.Reader) error {
+		b, err := ioutil.ReadAll(r)
+		uploaded = append(uploaded, string(b))
+		return err
+	}
+	ctrl.RecordCall(subject, "UploadMethod", "a", gomock.ReaderHasPrefix([]byte("first"))).Do(upload)
+	ctrl.RecordCall(subject, "UploadMethod", "a", gomock.ReaderHasPrefix([]byte("second"))).Do(upload)
+
+	// A pipe cannot be rewound, so the action is passed a copy of its content.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("second content"))
+		pw.Close()
+	}()
+	ctrl.Call(subject, "UploadMethod", "a", pr)
+
+	// A seekable reader is rewound, so the caller can still read it.
+	r := strings.NewReader("first content")
+	ctrl.Call(subject, "UploadMethod", "a", r)
+	if b, _ := ioutil.ReadAll(r); string(b) != "first content" {
+		t.Errorf("content left in reader = %q, want %q", b, "first content")
+	}
+
+	assertEqual(t, []string{"second content", "first content"}, uploaded)
+
+	ctrl.RecordCall(subject, "UploadMethod", "b", gomock.ReaderEq([]byte("hello")))
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "UploadMethod", "b", strings.NewReader("goodbye"))
+	}, "Got: reader of \"goodbye\" (*strings.Reader)\nWant: is a reader of \"hello\"")
+
+	reporter.assertFatal(func() {
+		// The expected call wasn't made.
+		ctrl.Finish()
+	})
+}
+
+func TestReaderMatcherReadsBeforeLocking(t *testing.T) {
+	_, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "UploadMethod", "a", gomock.ReaderEq([]byte("hi")))
+	ctrl.RecordCall(subject, "FooMethod", "start")
+
+	// The writer of the pipe calls a mock before writing, which must not wait
+	// for the call reading the pipe.
+	pr, pw := io.Pipe()
+	go func() {
+		ctrl.Call(subject, "FooMethod", "start")
+		pw.Write([]byte("hi"))
+		pw.Close()
+	}()
+
+	ctrl.Call(subject, "UploadMethod", "a", pr)
+
+	ctrl.Finish()
+}
+
+func BenchmarkCall(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").Return(1).AnyTimes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}
+}
+
+func BenchmarkCall_DefaultReturns(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "ErrorMethod", gomock.Any()).AnyTimes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctrl.Call(subject, "ErrorMethod", "argument")
+	}
+}
+
+func BenchmarkCall_Stub(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	subject := new(Subject)
+	ctrl.RecordStub(subject, "FooMethod", gomock.Any()).Return(1)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctrl.Call(subject, "FooMethod", "argument")
+	}
+}
+
+func BenchmarkCall_ManyExpectations(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	subject := new(Subject)
+	for i := 0; i < 10; i++ {
+		ctrl.RecordCall(subject, "FooMethod", fmt.Sprint(i)).Return(i).AnyTimes()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctrl.Call(subject, "FooMethod", "9")
+	}
+}
+
+func BenchmarkCall_Parallel(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	subject := new(Subject)
+	ctrl.RecordCall(subject, "FooMethod", "argument").Return(1).AnyTimes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			ctrl.Call(subject, "FooMethod", "argument")
+		}
+	})
+}
+
+func BenchmarkRecordCall(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	subject := new(Subject)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctrl.RecordCall(subject, "FooMethod", "argument").Return(1)
+		ctrl.Call(subject, "FooMethod", "argument")
+	}
+}
+
+func TestAnyTimesCallsAfterChanges(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", gomock.Any()).Return(1).AnyTimes()
+	for i := 0; i < 3; i++ {
+		assertEqual(t, []interface{}{1}, ctrl.Call(subject, "FooMethod", "a"))
+	}
+
+	// A call expected later is still matched first once.
+	ctrl.RecordCall(subject, "FooMethod", "b").Return(2)
+	assertEqual(t, []interface{}{1}, ctrl.Call(subject, "FooMethod", "b"))
+
+	// Changing a call after it was made changes how it matches.
+	limited := ctrl.RecordCall(subject, "BarMethod", "a").Return(3).AnyTimes()
+	assertEqual(t, []interface{}{3}, ctrl.Call(subject, "BarMethod", "a"))
+	limited.Times(2)
+	assertEqual(t, []interface{}{3}, ctrl.Call(subject, "BarMethod", "a"))
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "BarMethod", "a")
+	}, "has already been called the max number of times")
+
+	if got := ctrl.Expectations()[0].NumCalls; got != 4 {
+		t.Errorf("NumCalls = %d, want 4", got)
+	}
+
+	ctrl.Forbid(subject)
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "a")
+	}, "no more calls to this receiver are allowed")
+}
+
+func TestAnyTimesCallsConcurrently(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", "a").Return(1).MinTimes(100)
+	ctrl.RecordStub(subject, "FooMethod", gomock.Any()).Return(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if rets := ctrl.Call(subject, "FooMethod", "a"); rets[0] != 1 {
+					t.Errorf("got %v, want 1", rets[0])
+				}
+				if rets := ctrl.Call(subject, "FooMethod", "b"); rets[0] != 2 {
+					t.Errorf("got %v, want 2", rets[0])
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	ctrl.Finish()
+	reporter.assertPass("expected call made 100 times")
+}
+
+func TestActionResultsAreChecked(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+	subject := new(Subject)
+
+	ctrl.RecordCall(subject, "FooMethod", "wrong type").DoAndReturn(func(string) string {
+		return "one"
+	})
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "wrong type")
+	}, "wrong type of result 0 of *gomock_test.Subject.FooMethod: string is not assignable to int")
+
+	ctrl.RecordCall(subject, "FooMethod", "wrong number").DoAndReturn(func(string) (int, error) {
+		return 1, nil
+	})
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "wrong number")
+	}, "wrong number of results of *gomock_test.Subject.FooMethod: got 2, want 1")
+
+	ctrl.RecordCall(subject, "FooMethod", "nil").DoAndReturn(func(string) interface{} {
+		return nil
+	})
+	reporter.assertFatal(func() {
+		ctrl.Call(subject, "FooMethod", "nil")
+	}, "result 0 of *gomock_test.Subject.FooMethod is nil, but int is not nillable")
+
+	// Results of assignable types are converted, so that mocks can type
+	// assert them.
+	ctrl.RecordCall(subject, "NamesMethod").DoAndReturn(func() []string {
+		return []string{"a", "b"}
+	}).Times(2)
+	for i := 0; i < 2; i++ {
+		rets := ctrl.Call(subject, "NamesMethod")
+		if names, ok := rets[0].(Names); !ok || len(names) != 2 {
+			t.Errorf("got %#v, want Names{\"a\", \"b\"}", rets[0])
+		}
+	}
+
+	ctrl.RecordCall(subject, "ErrorMethod", "ok").DoAndReturn(func(string) (int, error) {
+		return 1, nil
+	})
+	assertEqual(t, []interface{}{1, nil}, ctrl.Call(subject, "ErrorMethod", "ok"))
+}
+
+func TestMockFunc(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+
+	var lookup func(key string, n int) (string, error)
+	m := gomock.MockFunc(ctrl, &lookup)
+
+	first := m.EXPECT("a", gomock.Any()).Return("A", nil)
+	m.EXPECT(gomock.Not("a"), 2).Return("", errors.New("not found")).Times(2)
+	m.EXPECT("a", 3).Return("AAA", nil).After(first)
+
+	if got, err := lookup("a", 3); got != "A" || err != nil {
+		t.Errorf(`lookup("a", 3) = %q, %v; want "A", nil`, got, err)
+	}
+	if got, err := lookup("a", 3); got != "AAA" || err != nil {
+		t.Errorf(`lookup("a", 3) = %q, %v; want "AAA", nil`, got, err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := lookup("b", 2); err == nil {
+			t.Errorf(`lookup("b", 2) succeeded, want an error`)
+		}
+	}
+
+	reporter.assertFatal(func() {
+		lookup("b", 2)
+	}, "Unexpected call to *gomock.FuncMock.Call([b 2]) at", "controller_test.go",
+		"has already been called the max number of times")
+
+	// All the expected calls were made.
+	ctrl.Finish()
+}
+
+func TestMockFuncVariadic(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+
+	var sum func(base int, xs ...int) int
+	m := gomock.MockFunc(ctrl, &sum)
+	m.EXPECT(1, 2, 3).Return(6)
+	m.EXPECT(0).Return(0)
+	m.STUB(gomock.Any(), gomock.Any()).DoAndReturn(func(base int, xs ...int) int {
+		return -1
+	})
+
+	assertEqual(t, 6, sum(1, 2, 3))
+	assertEqual(t, 0, sum(0))
+	assertEqual(t, -1, sum(5, 5))
+
+	// Results default to zero values, of interface types too.
+	var open func(name string) (io.Reader, error)
+	gomock.MockFunc(ctrl, &open).EXPECT("a")
+	if r, err := open("a"); r != nil || err != nil {
+		t.Errorf(`open("a") = %v, %v; want nil, nil`, r, err)
+	}
+
+	ctrl.Finish()
+	reporter.assertPass("all expected calls were made")
+}
+
+func TestMockFuncRequiresFuncPointer(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	defer reporter.recoverUnexpectedFatal()
+
+	var f func()
+	reporter.assertFatal(func() {
+		gomock.MockFunc(ctrl, f)
+	}, "MockFunc: got func(), want a non-nil pointer to a func")
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import (
+	"reflect"
+	"sync"
+)
+
+// mockConstructors maps interface types to constructors of their mocks.
+var mockConstructors sync.Map
+
+// RegisterMockConstructor registers ctor as the constructor of mocks for the
+// interface type typ. Controllers created with WithDeepStubs use it to return
+// mocks from unexpected calls with results of that type. mockgen generates
+// calls to it when run with -deep_stubs.
+func RegisterMockConstructor(typ reflect.Type, ctor func(*Controller) interface{}) {
+	mockConstructors.Store(typ, ctor)
+}
+
+type deepStubsOption struct{}
+
+func (deepStubsOption) apply(ctrl *Controller) {
+	ctrl.deepStubs = true
+}
+
+// WithDeepStubs makes unexpected calls to methods returning an interface with
+// a registered mock constructor (see RegisterMockConstructor) return a mock of
+// that interface instead of failing. Repeated calls to the same method of the
+// same mock return the same nested mock, so tests can reach it through the
+// parent to set expectations on it:
+//
+//   client := NewMockClient(ctrl)
+//   client.Users().(*MockUserService).EXPECT().Get(1).Return("gopher", nil)
+//
+// Nested mocks are lenient: unexpected calls to them return default values
+// (see Controller.SetDefault) instead of failing. Expected calls on them are
+// still verified.
+func WithDeepStubs() ControllerOption {
+	return deepStubsOption{}
+}
+
+// deepStubKey identifies the nested mock returned for a result of a method.
+type deepStubKey struct {
+	receiver interface{}
+	method   string
+	index    int
+}
+
+// deepStubReturns returns the results of an unexpected call when deep stubs
+// are enabled, or false if the call should fail. The controller's lock must be
+// held.
+func (ctrl *Controller) deepStubReturns(receiver interface{}, method string) ([]interface{}, bool) {
+	methodType := ctrl.methodType(receiver, method)
+	if methodType == nil {
+		return nil, false
+	}
+
+	rets := make([]interface{}, methodType.NumOut())
+	found := false
+	for i := range rets {
+		out := methodType.Out(i)
+		ctor, ok := mockConstructors.Load(out)
+		if !ok {
+			rets[i] = ctrl.defaults.value(out)
+			continue
+		}
+		found = true
+		if ctrl.defaults.has(out) {
+			// Registered defaults take precedence over nested mocks.
+			rets[i] = ctrl.defaults.value(out)
+			continue
+		}
+		key := deepStubKey{receiver, method, i}
+		m, ok := ctrl.deepMocks[key]
+		if !ok {
+			m = ctor.(func(*Controller) interface{})(ctrl)
+			if ctrl.deepMocks == nil {
+				ctrl.deepMocks = make(map[deepStubKey]interface{})
+				ctrl.lenient = make(map[interface{}]bool)
+			}
+			ctrl.deepMocks[key] = m
+			ctrl.lenient[m] = true
+		}
+		rets[i] = m
+	}
+	if !found && !ctrl.lenient[receiver] {
+		return nil, false
+	}
+	return rets, true
+}
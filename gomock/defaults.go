@@ -0,0 +1,125 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// defaultValues holds the values that calls return by default, by type.
+// Types without a registered default return their zero value.
+type defaultValues struct {
+	mu        sync.RWMutex
+	factories map[reflect.Type]func() reflect.Value
+}
+
+// set registers value as the default for typ. value is either assignable to
+// typ, or a function without arguments returning a single value assignable to
+// typ, which is then called every time a default is needed.
+func (d *defaultValues) set(typ reflect.Type, value interface{}) error {
+	var factory func() reflect.Value
+	v := reflect.ValueOf(value)
+	switch {
+	case value == nil:
+		switch typ.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		default:
+			return fmt.Errorf("default for %v is nil, but %v is not nillable", typ, typ)
+		}
+		factory = func() reflect.Value { return reflect.Zero(typ) }
+	case v.Type().AssignableTo(typ):
+		factory = func() reflect.Value { return v }
+	case v.Kind() == reflect.Func && v.Type().NumIn() == 0 && v.Type().NumOut() == 1 &&
+		v.Type().Out(0).AssignableTo(typ):
+		factory = func() reflect.Value { return v.Call(nil)[0] }
+	default:
+		return fmt.Errorf("default for %v must be assignable to it or a func() returning it, got %T", typ, value)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.factories == nil {
+		d.factories = make(map[reflect.Type]func() reflect.Value)
+	}
+	d.factories[typ] = factory
+	return nil
+}
+
+// has reports whether a default is registered for typ.
+func (d *defaultValues) has(typ reflect.Type) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	_, ok := d.factories[typ]
+	return ok
+}
+
+// value returns the default value for typ. It is safe to call on a nil
+// *defaultValues, which has no registered defaults.
+func (d *defaultValues) value(typ reflect.Type) interface{} {
+	if d == nil {
+		return reflect.Zero(typ).Interface()
+	}
+	d.mu.RLock()
+	factory, ok := d.factories[typ]
+	d.mu.RUnlock()
+	if !ok {
+		return reflect.Zero(typ).Interface()
+	}
+	// Convert to typ so that generated code can type assert the value.
+	v := reflect.New(typ).Elem()
+	v.Set(factory())
+	return v.Interface()
+}
+
+// zeroReturns holds the zero values of the results of method types, by
+// method type, since most calls return defaults that are zero. The slices are
+// shared by all controllers, so only copies of them are returned.
+var zeroReturns sync.Map // reflect.Type -> []interface{}
+
+// returns returns the default values for the results of methodType.
+func (d *defaultValues) returns(methodType reflect.Type) []interface{} {
+	if d.empty() {
+		zero, ok := zeroReturns.Load(methodType)
+		if !ok {
+			rets := make([]interface{}, methodType.NumOut())
+			for i := range rets {
+				rets[i] = reflect.Zero(methodType.Out(i)).Interface()
+			}
+			zero, _ = zeroReturns.LoadOrStore(methodType, rets)
+		}
+		rets := make([]interface{}, len(zero.([]interface{})))
+		copy(rets, zero.([]interface{}))
+		return rets
+	}
+
+	rets := make([]interface{}, methodType.NumOut())
+	for i := range rets {
+		rets[i] = d.value(methodType.Out(i))
+	}
+	return rets
+}
+
+// empty reports whether no defaults are registered. It is safe to call on a
+// nil *defaultValues.
+func (d *defaultValues) empty() bool {
+	if d == nil {
+		return true
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.factories) == 0
+}
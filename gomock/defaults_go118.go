@@ -0,0 +1,36 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package gomock
+
+import "reflect"
+
+// SetDefaultFor is like Controller.SetDefault, for results of type T. value
+// is either assignable to T, or a function without arguments that returns a
+// value assignable to T.
+//
+//	gomock.SetDefaultFor[error](ctrl, errNotImplemented)
+//	gomock.SetDefaultFor[io.ReadCloser](ctrl, func() io.ReadCloser {
+//	  return io.NopCloser(strings.NewReader(""))
+//	})
+func SetDefaultFor[T any](ctrl *Controller, value interface{}) {
+	ctrl.T.Helper()
+
+	if err := ctrl.defaults.set(reflect.TypeOf((*T)(nil)).Elem(), value); err != nil {
+		ctrl.T.Fatalf("gomock: SetDefaultFor: %v", err)
+	}
+}
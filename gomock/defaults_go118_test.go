@@ -0,0 +1,41 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package gomock_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestSetDefaultFor(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+
+	gomock.SetDefaultFor[error](ctrl, testError("not implemented"))
+	gomock.SetDefaultFor[int](ctrl, func() int { return 4 })
+
+	ctrl.RecordCall(subject, "ErrorMethod", "a")
+	assertEqual(t, []interface{}{4, testError("not implemented")}, ctrl.Call(subject, "ErrorMethod", "a"))
+	ctrl.Finish()
+	reporter.assertPass("calls with default values")
+
+	reporter.assertFatal(func() {
+		gomock.SetDefaultFor[int](ctrl, "zero")
+	}, "gomock: SetDefaultFor: default for int")
+}
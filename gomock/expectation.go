@@ -0,0 +1,141 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// An Expectation is a snapshot of the state of an expected call or a stub,
+// for debugging tests.
+type Expectation struct {
+	Receiver interface{} // the receiver of the method call
+	Method   string      // the name of the method
+	Args     []string    // descriptions of the argument matchers
+	Origin   string      // file and line number of call setup
+
+	MinCalls, MaxCalls int // bounds on the number of calls
+	NumCalls           int // actual number made
+
+	// PreReqs describes the prerequisite calls that still have to be
+	// satisfied before this call can match.
+	PreReqs []string
+
+	Satisfied bool // whether the minimum number of calls have been made
+	Exhausted bool // whether the maximum number of calls have been made
+	Stub      bool // whether the call was recorded with STUB and is not verified
+}
+
+func (e Expectation) String() string {
+	var state []string
+	if e.Stub {
+		state = append(state, "stub")
+	}
+	if e.Satisfied {
+		state = append(state, "satisfied")
+	} else {
+		state = append(state, "unsatisfied")
+	}
+	if e.Exhausted {
+		state = append(state, "exhausted")
+	}
+	return fmt.Sprintf("%T.%v(%s) %s: %d call(s) made, want %s; %s",
+		e.Receiver, e.Method, strings.Join(e.Args, ", "), e.Origin,
+		e.NumCalls, formatTimes(e.MinCalls, e.MaxCalls), strings.Join(state, ", "))
+}
+
+func formatTimes(min, max int) string {
+	switch {
+	case min == max:
+		return fmt.Sprint(min)
+	case min == 0 && max >= 1e8:
+		return "any number"
+	case max >= 1e8:
+		return fmt.Sprintf("at least %d", min)
+	default:
+		return fmt.Sprintf("%d to %d", min, max)
+	}
+}
+
+func newExpectation(c *Call) Expectation {
+	args := make([]string, len(c.args))
+	for i, arg := range c.args {
+		args[i] = describe(arg, c.formatters)
+	}
+	var preReqs []string
+	for _, preReq := range c.preReqs {
+		if !preReq.satisfied() {
+			preReqs = append(preReqs, preReq.String())
+		}
+	}
+	return Expectation{
+		Receiver:  c.receiver,
+		Method:    c.method,
+		Args:      args,
+		Origin:    c.origin(),
+		MinCalls:  c.minCalls,
+		MaxCalls:  c.maxCalls,
+		NumCalls:  c.count(),
+		PreReqs:   preReqs,
+		Satisfied: c.satisfied(),
+		Exhausted: c.exhausted(),
+		Stub:      c.stub,
+	}
+}
+
+// Expectations returns a snapshot of the expected calls and stubs recorded
+// on the controller, including exhausted ones, in the order they were
+// recorded.
+func (ctrl *Controller) Expectations() []Expectation {
+	ctrl.mu.Lock()
+	defer ctrl.mu.Unlock()
+
+	var calls []*Call
+	for _, m := range []map[callSetKey][]*Call{ctrl.expectedCalls.expected, ctrl.expectedCalls.exhausted, ctrl.expectedCalls.stubs} {
+		for _, cs := range m {
+			calls = append(calls, cs...)
+		}
+	}
+	sort.Slice(calls, func(i, j int) bool { return calls[i].seq < calls[j].seq })
+
+	exps := make([]Expectation, len(calls))
+	for i, c := range calls {
+		exps[i] = newExpectation(c)
+	}
+	return exps
+}
+
+// Dump writes a human readable description of the controller's expectations
+// and their state to w.
+func (ctrl *Controller) Dump(w io.Writer) error {
+	exps := ctrl.Expectations()
+	if _, err := fmt.Fprintf(w, "%d expectation(s):\n", len(exps)); err != nil {
+		return err
+	}
+	for _, e := range exps {
+		if _, err := fmt.Fprintf(w, "%v\n", e); err != nil {
+			return err
+		}
+		for _, preReq := range e.PreReqs {
+			if _, err := fmt.Fprintf(w, "\tafter %s\n", preReq); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
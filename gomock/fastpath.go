@@ -0,0 +1,94 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import "sync/atomic"
+
+// fastCalls holds the calls that can be matched without taking the
+// controller's lock, by receiver and method, in the order they are matched.
+// It is not modified once stored in Controller.fast.
+//
+// Only methods whose expected calls and stubs may all be called any number of
+// times and do not depend on other calls are included, since matching those
+// does not change what later calls match. Any other change to the expected
+// calls resets the fast path, and methods are added back to it as they are
+// called.
+type fastCalls map[callSetKey][]*Call
+
+// fastCall matches a call without taking the controller's lock, and returns
+// the actions to run with the arguments to pass them. It returns false if the
+// call must go through Controller.Call, which may also mean it is unexpected.
+func (ctrl *Controller) fastCall(receiver interface{}, method string, args []interface{}) ([]func([]interface{}) []interface{}, []interface{}, bool) {
+	fc, _ := ctrl.fast.Load().(fastCalls)
+	if len(fc) == 0 {
+		return nil, nil, false
+	}
+	for _, call := range fc[callSetKey{receiver, method}] {
+		// The call may have been modified since it was added.
+		if !call.fast() {
+			return nil, nil, false
+		}
+		// Fast calls have no prerequisites, and cannot practically be
+		// exhausted, so only their arguments need to match.
+		if call.matchArgs(args, false) == nil {
+			atomic.AddInt64(&call.numFastCalls, 1)
+			return call.actions, replayStreams(call.methodType, args), true
+		}
+	}
+	return nil, nil, false
+}
+
+// updateFastPath adds the calls of key to the fast path, if they can all be
+// matched without taking the lock. ctrl.mu must be held.
+func (ctrl *Controller) updateFastPath(key callSetKey) {
+	if ctrl.finished || ctrl.expectedCalls.Forbidden(key.receiver) {
+		return
+	}
+	old, _ := ctrl.fast.Load().(fastCalls)
+	if _, ok := old[key]; ok {
+		return
+	}
+	// Calls that match an exhausted call must fail rather than match a stub.
+	if len(ctrl.expectedCalls.exhausted[key]) != 0 {
+		return
+	}
+
+	expected, stubs := ctrl.expectedCalls.expected[key], ctrl.expectedCalls.stubs[key]
+	calls := make([]*Call, 0, len(expected)+len(stubs))
+	for _, call := range expected {
+		if !call.fast() {
+			return
+		}
+		calls = append(calls, call)
+	}
+	for i := len(stubs) - 1; i >= 0; i-- {
+		if !stubs[i].fast() {
+			return
+		}
+		calls = append(calls, stubs[i])
+	}
+
+	fc := make(fastCalls, len(old)+1)
+	for k, v := range old {
+		fc[k] = v
+	}
+	fc[key] = calls
+	ctrl.fast.Store(fc)
+}
+
+// resetFastPath removes all calls from the fast path. ctrl.mu must be held.
+func (ctrl *Controller) resetFastPath() {
+	ctrl.fast.Store(fastCalls(nil))
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// maxFormattedLength is the length after which formatted values are
+// truncated in failure messages.
+const maxFormattedLength = 1024
+
+// formatters holds the functions used to format values of given types in
+// failure messages. Lookups fall back to the parent, and eventually to %v.
+type formatters struct {
+	parent *formatters
+
+	mu      sync.RWMutex
+	entries []formatterEntry
+}
+
+type formatterEntry struct {
+	typ reflect.Type
+	fn  func(interface{}) string
+}
+
+// globalFormatters holds the formatters registered with RegisterFormatter.
+var globalFormatters formatters
+
+// RegisterFormatter registers f to format values of type typ in all failure
+// messages, such as the received and expected arguments of calls. If typ is an
+// interface type, f also formats values of the types implementing it, unless
+// they have a formatter of their own. Formatters registered later take
+// precedence.
+//
+//	gomock.RegisterFormatter(reflect.TypeOf([]byte(nil)), func(x interface{}) string {
+//	  return fmt.Sprintf("%q", x)
+//	})
+func RegisterFormatter(typ reflect.Type, f func(interface{}) string) {
+	globalFormatters.register(typ, f)
+}
+
+// RegisterFormatter registers f to format values of type typ in the failure
+// messages of this controller, taking precedence over the formatters
+// registered with the package-level RegisterFormatter.
+func (ctrl *Controller) RegisterFormatter(typ reflect.Type, f func(interface{}) string) {
+	ctrl.formatters.register(typ, f)
+}
+
+func (fs *formatters) register(typ reflect.Type, f func(interface{}) string) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.entries = append(fs.entries, formatterEntry{typ, f})
+}
+
+// lookup returns the formatter for values of type typ, or nil.
+func (fs *formatters) lookup(typ reflect.Type) func(interface{}) string {
+	for ; fs != nil; fs = fs.parent {
+		fs.mu.RLock()
+		entries := fs.entries
+		fs.mu.RUnlock()
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].typ == typ {
+				return entries[i].fn
+			}
+		}
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].typ.Kind() == reflect.Interface && typ.Implements(entries[i].typ) {
+				return entries[i].fn
+			}
+		}
+	}
+	return nil
+}
+
+// format formats x for a failure message. It is safe to call on a nil
+// *formatters, which uses the global formatters.
+func (fs *formatters) format(x interface{}) string {
+	if x == nil {
+		return "<nil>"
+	}
+	if fs == nil {
+		fs = &globalFormatters
+	}
+	var s string
+	if f := fs.lookup(reflect.TypeOf(x)); f != nil {
+		s = f(x)
+	} else {
+		s = fmt.Sprintf("%v", x)
+	}
+	return truncate(s)
+}
+
+// formatArgs formats the arguments of a call the way %v formats a slice.
+func (fs *formatters) formatArgs(args []interface{}) string {
+	ss := make([]string, len(args))
+	for i, arg := range args {
+		ss[i] = fs.format(arg)
+	}
+	return "[" + strings.Join(ss, " ") + "]"
+}
+
+// truncate shortens s to about maxFormattedLength bytes, noting how much was
+// left out.
+func truncate(s string) string {
+	if len(s) <= maxFormattedLength {
+		return s
+	}
+	n := maxFormattedLength
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return fmt.Sprintf("%s... (%d more bytes)", s[:n], len(s)-n)
+}
+
+// A describer is a Matcher that can describe itself with the values it holds
+// formatted by fs.
+type describer interface {
+	describe(fs *formatters) string
+}
+
+// describe returns the description of m, formatting values with fs where m
+// supports it.
+func describe(m Matcher, fs *formatters) string {
+	if d, ok := m.(describer); ok {
+		return d.describe(fs)
+	}
+	return m.String()
+}
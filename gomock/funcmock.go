@@ -0,0 +1,85 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import "reflect"
+
+// FuncMock is a mock of a function, created by MockFunc.
+type FuncMock struct {
+	ctrl *Controller
+	typ  reflect.Type
+}
+
+// MockFunc sets the function pointed to by fnPtr to a mock function, and
+// returns the mock to record the calls expected of it. Calls to the function
+// go through ctrl like calls to a generated mock, as calls to the method Call
+// of the returned *FuncMock.
+//
+//	var now func() time.Time
+//	gomock.MockFunc(ctrl, &now).EXPECT().Return(time.Unix(0, 0))
+func MockFunc(ctrl *Controller, fnPtr interface{}) *FuncMock {
+	ctrl.T.Helper()
+
+	v := reflect.ValueOf(fnPtr)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Func {
+		ctrl.T.Fatalf("gomock: MockFunc: got %T, want a non-nil pointer to a func", fnPtr)
+		return nil
+	}
+	m := &FuncMock{ctrl: ctrl, typ: v.Elem().Type()}
+	v.Elem().Set(reflect.MakeFunc(m.typ, m.call))
+	return m
+}
+
+// EXPECT records an expected call of the function with the given arguments,
+// which are matchers or values to match with Eq, like the arguments of the
+// methods of a generated mock's recorder.
+func (m *FuncMock) EXPECT(args ...interface{}) *Call {
+	m.ctrl.T.Helper()
+	return m.ctrl.RecordCallWithMethodType(m, "Call", m.typ, args...)
+}
+
+// STUB records the default behavior of calls of the function with the given
+// arguments, like the STUB method of a generated mock.
+func (m *FuncMock) STUB(args ...interface{}) *Call {
+	m.ctrl.T.Helper()
+	return m.ctrl.RecordStubWithMethodType(m, "Call", m.typ, args...)
+}
+
+// call is the implementation of the mock function.
+func (m *FuncMock) call(in []reflect.Value) []reflect.Value {
+	m.ctrl.T.Helper()
+
+	args := make([]interface{}, 0, len(in))
+	for i, v := range in {
+		if m.typ.IsVariadic() && i == len(in)-1 {
+			// Pass variadic arguments separately, like generated mocks do.
+			for j := 0; j < v.Len(); j++ {
+				args = append(args, v.Index(j).Interface())
+			}
+			continue
+		}
+		args = append(args, v.Interface())
+	}
+
+	rets := m.ctrl.Call(m, "Call", args...)
+	out := make([]reflect.Value, m.typ.NumOut())
+	for i := range out {
+		out[i] = reflect.New(m.typ.Out(i)).Elem()
+		if i < len(rets) && rets[i] != nil {
+			out[i].Set(reflect.ValueOf(rets[i]))
+		}
+	}
+	return out
+}
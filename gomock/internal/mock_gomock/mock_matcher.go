@@ -19,12 +19,13 @@ type MockMatcher struct {
 // MockMatcherMockRecorder is the mock recorder for MockMatcher.
 type MockMatcherMockRecorder struct {
 	mock *MockMatcher
+	stub bool
 }
 
 // NewMockMatcher creates a new mock instance.
 func NewMockMatcher(ctrl *gomock.Controller) *MockMatcher {
 	mock := &MockMatcher{ctrl: ctrl}
-	mock.recorder = &MockMatcherMockRecorder{mock}
+	mock.recorder = &MockMatcherMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockMatcher) EXPECT() *MockMatcherMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockMatcher) STUB() *MockMatcherMockRecorder {
+	return &MockMatcherMockRecorder{mock: m, stub: true}
+}
+
 // Matches mocks base method.
 func (m *MockMatcher) Matches(arg0 interface{}) bool {
 	m.ctrl.T.Helper()
@@ -44,6 +51,9 @@ func (m *MockMatcher) Matches(arg0 interface{}) bool {
 // Matches indicates an expected call of Matches.
 func (mr *MockMatcherMockRecorder) Matches(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Matches", reflect.TypeOf((*MockMatcher)(nil).Matches), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Matches", reflect.TypeOf((*MockMatcher)(nil).Matches), arg0)
 }
 
@@ -58,5 +68,8 @@ func (m *MockMatcher) String() string {
 // String indicates an expected call of String.
 func (mr *MockMatcherMockRecorder) String() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "String", reflect.TypeOf((*MockMatcher)(nil).String))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "String", reflect.TypeOf((*MockMatcher)(nil).String))
 }
@@ -0,0 +1,62 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A LateCall records a call to a mock that was made after its Controller
+// finished, typically from a goroutine that outlived the test.
+type LateCall struct {
+	Receiver interface{}   // the receiver of the method call
+	Method   string        // the name of the method
+	Args     []interface{} // the arguments of the call
+	Origin   string        // file and line number of the call site
+	Stack    string        // stack trace of the calling goroutine
+
+	err        error       // why the call would not have matched, if it would not have
+	formatters *formatters // formatters of the arguments
+}
+
+func (c *LateCall) String() string {
+	reason := "the controller has already finished"
+	if c.err != nil {
+		reason += "; " + c.err.Error()
+	}
+	return fmt.Sprintf("Unexpected call to %T.%v(%s) at %s because: %s\n%s",
+		c.Receiver, c.Method, c.formatters.formatArgs(c.Args), c.Origin, reason, c.Stack)
+}
+
+// pendingLateCalls holds late calls that should fail the next test.
+var pendingLateCalls struct {
+	mu    sync.Mutex
+	calls []*LateCall
+}
+
+func addPendingLateCall(c *LateCall) {
+	pendingLateCalls.mu.Lock()
+	defer pendingLateCalls.mu.Unlock()
+	pendingLateCalls.calls = append(pendingLateCalls.calls, c)
+}
+
+func takePendingLateCalls() []*LateCall {
+	pendingLateCalls.mu.Lock()
+	defer pendingLateCalls.mu.Unlock()
+	calls := pendingLateCalls.calls
+	pendingLateCalls.calls = nil
+	return calls
+}
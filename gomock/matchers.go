@@ -120,7 +120,11 @@ func (e eqMatcher) Matches(x interface{}) bool {
 }
 
 func (e eqMatcher) String() string {
-	return fmt.Sprintf("is equal to %v (%T)", e.x, e.x)
+	return e.describe(nil)
+}
+
+func (e eqMatcher) describe(fs *formatters) string {
+	return fmt.Sprintf("is equal to %s (%T)", fs.format(e.x), e.x)
 }
 
 type nilMatcher struct{}
@@ -153,7 +157,11 @@ func (n notMatcher) Matches(x interface{}) bool {
 }
 
 func (n notMatcher) String() string {
-	return "not(" + n.m.String() + ")"
+	return n.describe(nil)
+}
+
+func (n notMatcher) describe(fs *formatters) string {
+	return "not(" + describe(n.m, fs) + ")"
 }
 
 type assignableToTypeOfMatcher struct {
@@ -182,9 +190,13 @@ func (am allMatcher) Matches(x interface{}) bool {
 }
 
 func (am allMatcher) String() string {
+	return am.describe(nil)
+}
+
+func (am allMatcher) describe(fs *formatters) string {
 	ss := make([]string, 0, len(am.matchers))
 	for _, matcher := range am.matchers {
-		ss = append(ss, matcher.String())
+		ss = append(ss, describe(matcher, fs))
 	}
 	return strings.Join(ss, "; ")
 }
@@ -268,7 +280,11 @@ func (m inAnyOrderMatcher) prepareValue(x interface{}) (reflect.Value, bool) {
 }
 
 func (m inAnyOrderMatcher) String() string {
-	return fmt.Sprintf("has the same elements as %v", m.x)
+	return m.describe(nil)
+}
+
+func (m inAnyOrderMatcher) describe(fs *formatters) string {
+	return fmt.Sprintf("has the same elements as %s", fs.format(m.x))
 }
 
 // Constructors
@@ -0,0 +1,281 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpmatch provides gomock matchers for *http.Request arguments.
+//
+// Matchers that take a value accept either a gomock.Matcher or a plain value,
+// which is matched with gomock.Eq. Matchers can be combined with gomock.All:
+//
+//	m.EXPECT().RoundTrip(gomock.All(
+//	  httpmatch.Method(http.MethodPost),
+//	  httpmatch.Path("/users"),
+//	  httpmatch.JSONBody(map[string]interface{}{"name": "gopher"}),
+//	))
+//
+// The body matchers read the request body and replace it with an unread copy,
+// so that neither the code under test nor the actions of the call see a
+// consumed body.
+package httpmatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+)
+
+// requestMatcher matches a part of an *http.Request, as returned by get, with
+// a matcher.
+type requestMatcher struct {
+	what string
+	get  func(r *http.Request) (interface{}, bool)
+	m    gomock.Matcher
+}
+
+func (rm requestMatcher) Matches(x interface{}) bool {
+	r, ok := x.(*http.Request)
+	if !ok || r == nil {
+		return false
+	}
+	v, ok := rm.get(r)
+	return ok && rm.m.Matches(v)
+}
+
+func (rm requestMatcher) String() string {
+	return fmt.Sprintf("is a request with %s that %s", rm.what, rm.m)
+}
+
+// Got implements gomock.GotFormatter, describing the matched part of the
+// request rather than the whole request.
+func (rm requestMatcher) Got(got interface{}) string {
+	r, ok := got.(*http.Request)
+	if !ok || r == nil {
+		return fmt.Sprintf("%v (%T)", got, got)
+	}
+	v, ok := rm.get(r)
+	if !ok {
+		return fmt.Sprintf("%s %s with no %s", r.Method, r.URL, rm.what)
+	}
+	return fmt.Sprintf("%s %s with %s %v (%T)", r.Method, r.URL, rm.what, v, v)
+}
+
+// matcherOf returns v if it is a matcher, or a matcher for values equal to v.
+func matcherOf(v interface{}) gomock.Matcher {
+	if m, ok := v.(gomock.Matcher); ok {
+		return m
+	}
+	return gomock.Eq(v)
+}
+
+// anyOf returns a matcher for slices containing an element matched by m.
+func anyOf(m gomock.Matcher) gomock.Matcher {
+	return anyOfMatcher{m}
+}
+
+type anyOfMatcher struct {
+	m gomock.Matcher
+}
+
+func (am anyOfMatcher) Matches(x interface{}) bool {
+	for _, v := range x.([]string) {
+		if am.m.Matches(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (am anyOfMatcher) String() string {
+	return "has a value that " + am.m.String()
+}
+
+// Method returns a matcher for requests whose method matches method. A
+// request method of "" is treated as GET, as it is by http.Client.
+func Method(method interface{}) gomock.Matcher {
+	return requestMatcher{
+		what: "method",
+		get: func(r *http.Request) (interface{}, bool) {
+			if r.Method == "" {
+				return http.MethodGet, true
+			}
+			return r.Method, true
+		},
+		m: matcherOf(method),
+	}
+}
+
+// Path returns a matcher for requests whose URL path matches path.
+func Path(path interface{}) gomock.Matcher {
+	return requestMatcher{
+		what: "path",
+		get: func(r *http.Request) (interface{}, bool) {
+			if r.URL == nil {
+				return nil, false
+			}
+			return r.URL.Path, true
+		},
+		m: matcherOf(path),
+	}
+}
+
+// Query returns a matcher for requests with a URL query parameter named key
+// that has a value matching value.
+func Query(key string, value interface{}) gomock.Matcher {
+	return requestMatcher{
+		what: fmt.Sprintf("query parameter %q", key),
+		get: func(r *http.Request) (interface{}, bool) {
+			if r.URL == nil {
+				return nil, false
+			}
+			vs, ok := r.URL.Query()[key]
+			return vs, ok
+		},
+		m: anyOf(matcherOf(value)),
+	}
+}
+
+// Header returns a matcher for requests with a header named key that has a
+// value matching value.
+func Header(key string, value interface{}) gomock.Matcher {
+	return requestMatcher{
+		what: fmt.Sprintf("header %q", key),
+		get: func(r *http.Request) (interface{}, bool) {
+			vs := r.Header.Values(key)
+			return vs, len(vs) > 0
+		},
+		m: anyOf(matcherOf(value)),
+	}
+}
+
+// FormValue returns a matcher for requests with a form field named key, in
+// either the URL query or the body, that has a value matching value. Both
+// URL-encoded and multipart bodies are supported.
+func FormValue(key string, value interface{}) gomock.Matcher {
+	return requestMatcher{
+		what: fmt.Sprintf("form value %q", key),
+		get: func(r *http.Request) (interface{}, bool) {
+			form, err := parseForm(r)
+			if err != nil {
+				return nil, false
+			}
+			vs, ok := form[key]
+			return vs, ok
+		},
+		m: anyOf(matcherOf(value)),
+	}
+}
+
+// JSONBody returns a matcher for requests whose body is JSON matching value.
+// If value is a matcher, it is applied to the body decoded into an
+// interface{}. Otherwise value is encoded as JSON and compared with the body
+// regardless of formatting and the order of object keys.
+func JSONBody(value interface{}) gomock.Matcher {
+	m, ok := value.(gomock.Matcher)
+	if !ok {
+		m = jsonMatcher{value}
+	}
+	return requestMatcher{
+		what: "JSON body",
+		get: func(r *http.Request) (interface{}, bool) {
+			body, err := readBody(r)
+			if err != nil {
+				return nil, false
+			}
+			var v interface{}
+			if err := json.Unmarshal(body, &v); err != nil {
+				return nil, false
+			}
+			return v, true
+		},
+		m: m,
+	}
+}
+
+type jsonMatcher struct {
+	want interface{}
+}
+
+func (jm jsonMatcher) Matches(x interface{}) bool {
+	b, err := json.Marshal(jm.want)
+	if err != nil {
+		return false
+	}
+	var want interface{}
+	if err := json.Unmarshal(b, &want); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(want, x)
+}
+
+func (jm jsonMatcher) String() string {
+	b, err := json.Marshal(jm.want)
+	if err != nil {
+		return fmt.Sprintf("is equal to %v (not valid JSON: %v)", jm.want, err)
+	}
+	return "is equal to " + string(b)
+}
+
+// readBody returns the body of r, replacing it with an unread copy. If reading
+// the body fails, the copy fails with the same error after the content read
+// before it.
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	var rd io.Reader = bytes.NewReader(body)
+	if err != nil {
+		rd = io.MultiReader(rd, errReader{err})
+	}
+	r.Body = ioutil.NopCloser(rd)
+	return body, err
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// maxMemory is the amount of a multipart form that is kept in memory.
+const maxMemory = 32 << 20
+
+// parseForm returns the form values of r without consuming its body or
+// populating its form fields.
+func parseForm(r *http.Request) (map[string][]string, error) {
+	if r.Form != nil {
+		return r.Form, nil
+	}
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	c := r.Clone(r.Context())
+	c.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err := c.ParseMultipartForm(maxMemory); err != nil && err != http.ErrNotMultipart {
+		return nil, err
+	}
+	if c.MultipartForm != nil {
+		defer c.MultipartForm.RemoveAll()
+	}
+	return c.Form, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpmatch_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/mock/gomock/matchers/httpmatch"
+)
+
+func newRequest(method, target, contentType, body string) *http.Request {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	return r
+}
+
+func TestMatchers(t *testing.T) {
+	get := func() *http.Request {
+		r := newRequest(http.MethodGet, "/users?id=1&id=2&q=go", "", "")
+		r.Header.Add("Accept", "text/plain")
+		r.Header.Add("Accept", "application/json")
+		return r
+	}
+	post := func() *http.Request {
+		return newRequest(http.MethodPost, "/users?draft=true", "application/json", `{"name": "gopher", "tags": ["a", "b"]}`)
+	}
+	form := func() *http.Request {
+		return newRequest(http.MethodPost, "/login?next=home", "application/x-www-form-urlencoded", "user=gopher&remember=1")
+	}
+
+	tests := []struct {
+		name    string
+		matcher gomock.Matcher
+		yes, no []*http.Request
+	}{
+		{"Method", httpmatch.Method(http.MethodGet), []*http.Request{get()}, []*http.Request{post()}},
+		{"Method matcher", httpmatch.Method(gomock.Not(http.MethodGet)), []*http.Request{post()}, []*http.Request{get()}},
+		{"Method empty", httpmatch.Method(gomock.Eq(http.MethodGet)), []*http.Request{{Method: ""}}, []*http.Request{post()}},
+		{"Path", httpmatch.Path("/users"), []*http.Request{get(), post()}, []*http.Request{form()}},
+		{"Path matcher", httpmatch.Path(gomock.Not("/users")), []*http.Request{form()}, []*http.Request{get()}},
+		{"Query", httpmatch.Query("id", "2"), []*http.Request{get()}, []*http.Request{post()}},
+		{"Query missing", httpmatch.Query("id", gomock.Any()), []*http.Request{get()}, []*http.Request{form()}},
+		{"Header", httpmatch.Header("accept", "application/json"), []*http.Request{get()}, []*http.Request{form()}},
+		{"FormValue body", httpmatch.FormValue("user", "gopher"), []*http.Request{form()}, []*http.Request{get(), post()}},
+		{"FormValue query", httpmatch.FormValue("next", "home"), []*http.Request{form()}, []*http.Request{get()}},
+		{"JSONBody", httpmatch.JSONBody(map[string]interface{}{"tags": []string{"a", "b"}, "name": "gopher"}),
+			[]*http.Request{post()}, []*http.Request{get(), form()}},
+		{"JSONBody struct", httpmatch.JSONBody(struct {
+			Name string   `json:"name"`
+			Tags []string `json:"tags"`
+		}{"gopher", []string{"a", "b"}}), []*http.Request{post()}, nil},
+		{"JSONBody matcher", httpmatch.JSONBody(gomock.Not(gomock.Nil())), []*http.Request{post()}, []*http.Request{get()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, r := range tt.yes {
+				if !tt.matcher.Matches(r) {
+					t.Errorf("%v: expected match for %s %s", tt.matcher, r.Method, r.URL)
+				}
+			}
+			for _, r := range tt.no {
+				if tt.matcher.Matches(r) {
+					t.Errorf("%v: expected no match for %s %s", tt.matcher, r.Method, r.URL)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchersRejectOtherValues(t *testing.T) {
+	for _, m := range []gomock.Matcher{
+		httpmatch.Method(http.MethodGet),
+		httpmatch.Path(gomock.Any()),
+		httpmatch.JSONBody(gomock.Any()),
+	} {
+		for _, x := range []interface{}{nil, (*http.Request)(nil), "GET", http.Request{}} {
+			if m.Matches(x) {
+				t.Errorf("%v: expected no match for %#v", m, x)
+			}
+		}
+	}
+}
+
+func TestBodyIsNotConsumed(t *testing.T) {
+	const body = `{"name": "gopher"}`
+	r := newRequest(http.MethodPost, "/", "application/json", body)
+
+	if !httpmatch.JSONBody(map[string]string{"name": "gopher"}).Matches(r) {
+		t.Fatal("expected JSON body to match")
+	}
+	if httpmatch.JSONBody(map[string]string{"name": "other"}).Matches(r) {
+		t.Fatal("expected JSON body not to match")
+	}
+
+	got, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != body {
+		t.Errorf("body after matching = %q, want %q", got, body)
+	}
+}
+
+// failingReader returns its content, and then fails with err.
+type failingReader struct {
+	content string
+	err     error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.content == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.content)
+	r.content = r.content[n:]
+	return n, nil
+}
+
+func TestBodyReadErrorIsKept(t *testing.T) {
+	errBroken := errors.New("broken body")
+	r := httptest.NewRequest(http.MethodPost, "/", &failingReader{content: `{"name"`, err: errBroken})
+
+	if httpmatch.JSONBody(gomock.Any()).Matches(r) {
+		t.Fatal("expected a body that fails to be read not to match")
+	}
+
+	got, err := ioutil.ReadAll(r.Body)
+	if err != errBroken {
+		t.Errorf("error reading body after matching = %v, want %v", err, errBroken)
+	}
+	if string(got) != `{"name"` {
+		t.Errorf("body after matching = %q, want %q", got, `{"name"`)
+	}
+}
+
+func TestFormValueIsNotConsumed(t *testing.T) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if err := w.WriteField("user", "gopher"); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r := newRequest(http.MethodPost, "/", w.FormDataContentType(), buf.String())
+
+	if !httpmatch.FormValue("user", "gopher").Matches(r) {
+		t.Fatal("expected multipart form value to match")
+	}
+	if r.Form != nil || r.MultipartForm != nil {
+		t.Error("matching populated the form of the request")
+	}
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.FormValue("user"); got != "gopher" {
+		t.Errorf("FormValue after matching = %q, want %q", got, "gopher")
+	}
+}
+
+func TestFailureMessage(t *testing.T) {
+	m := httpmatch.Query("q", "go")
+	if got, want := m.String(), `is a request with query parameter "q" that has a value that is equal to go (string)`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	gf, ok := m.(gomock.GotFormatter)
+	if !ok {
+		t.Fatal("matcher does not implement gomock.GotFormatter")
+	}
+	r := newRequest(http.MethodGet, "/search?q=rust", "", "")
+	if got, want := gf.Got(r), `GET /search?q=rust with query parameter "q" [rust] ([]string)`; got != want {
+		t.Errorf("Got() = %q, want %q", got, want)
+	}
+}
@@ -17,9 +17,13 @@ package gomock_test
 //go:generate mockgen -destination internal/mock_gomock/mock_matcher.go github.com/golang/mock/gomock Matcher
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
+	"io/ioutil"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -293,3 +297,70 @@ func TestInAnyOrder(t *testing.T) {
 		})
 	}
 }
+
+// onceReader is a reader that cannot be rewound.
+type onceReader struct {
+	r io.Reader
+}
+
+func (r *onceReader) Read(p []byte) (int, error) {
+	return r.r.Read(p)
+}
+
+func TestReaderMatchers(t *testing.T) {
+	isGopher := func(v interface{}) bool {
+		m, ok := v.(map[string]interface{})
+		return ok && m["name"] == "gopher"
+	}
+	twoLines := func(lines []string) bool { return len(lines) == 2 }
+
+	tests := []struct {
+		name    string
+		matcher gomock.Matcher
+		yes, no []string
+	}{
+		{"ReaderEq", gomock.ReaderEq([]byte("hello")), []string{"hello"}, []string{"", "hell", "hello!"}},
+		{"ReaderHasPrefix", gomock.ReaderHasPrefix([]byte("he")), []string{"he", "hello"}, []string{"", "hi"}},
+		{"ReaderMatchesRegexp", gomock.ReaderMatchesRegexp(`^h.*o$`), []string{"hello", "ho"}, []string{"hello!"}},
+		{"ReaderJSON", gomock.ReaderJSON(isGopher), []string{`{"name": "gopher"}`}, []string{`{"name": "other"}`, `not json`}},
+		{"ReaderLines", gomock.ReaderLines(twoLines), []string{"a\nb", "a\r\nb\r\n"}, []string{"", "a\n", "a\nb\nc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range tt.yes {
+				if !tt.matcher.Matches(strings.NewReader(s)) {
+					t.Errorf("%v: expected match for %q", tt.matcher, s)
+				}
+			}
+			for _, s := range tt.no {
+				if tt.matcher.Matches(bytes.NewBufferString(s)) {
+					t.Errorf("%v: expected no match for %q", tt.matcher, s)
+				}
+			}
+			for _, x := range []interface{}{nil, "hello", (*strings.Reader)(nil)} {
+				if tt.matcher.Matches(x) {
+					t.Errorf("%v: expected no match for %#v", tt.matcher, x)
+				}
+			}
+		})
+	}
+}
+
+func TestReaderMatchersRewind(t *testing.T) {
+	m := gomock.ReaderEq([]byte("hello"))
+	for _, r := range []io.Reader{strings.NewReader("hello"), bytes.NewBufferString("hello")} {
+		if !m.Matches(r) || !m.Matches(r) {
+			t.Errorf("%T: expected repeated matches", r)
+		}
+		if b, err := ioutil.ReadAll(r); err != nil || string(b) != "hello" {
+			t.Errorf("%T: content after matching = %q, %v; want %q", r, b, err, "hello")
+		}
+	}
+
+	// The content of a reader is only kept during a call of a mock, so outside
+	// of one a reader that cannot be rewound is read by the first match.
+	r := &onceReader{strings.NewReader("hello")}
+	if !m.Matches(r) || m.Matches(r) {
+		t.Error("expected a single match of a reader that cannot be rewound")
+	}
+}
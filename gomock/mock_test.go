@@ -19,12 +19,13 @@ type MockFoo struct {
 // MockFooMockRecorder is the mock recorder for MockFoo.
 type MockFooMockRecorder struct {
 	mock *MockFoo
+	stub bool
 }
 
 // NewMockFoo creates a new mock instance.
 func NewMockFoo(ctrl *gomock.Controller) *MockFoo {
 	mock := &MockFoo{ctrl: ctrl}
-	mock.recorder = &MockFooMockRecorder{mock}
+	mock.recorder = &MockFooMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockFoo) EXPECT() *MockFooMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockFoo) STUB() *MockFooMockRecorder {
+	return &MockFooMockRecorder{mock: m, stub: true}
+}
+
 // Bar mocks base method.
 func (m *MockFoo) Bar(arg0 string) string {
 	m.ctrl.T.Helper()
@@ -44,5 +51,8 @@ func (m *MockFoo) Bar(arg0 string) string {
 // Bar indicates an expected call of Bar.
 func (mr *MockFooMockRecorder) Bar(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockFoo)(nil).Bar), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockFoo)(nil).Bar), arg0)
 }
@@ -0,0 +1,38 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package gomock
+
+import "reflect"
+
+// Result returns the result at index i of rets, the results of a call of
+// method of receiver returned by ctrl.Call, as a T. A nil or missing result is
+// the zero T, and a result of another type fails the test. It is called by
+// generated mocks, and should not be called by user code.
+func Result[T any](ctrl *Controller, receiver interface{}, method string, rets []interface{}, i int) T {
+	var zero T
+	if i >= len(rets) || rets[i] == nil {
+		return zero
+	}
+	ret, ok := rets[i].(T)
+	if !ok {
+		ctrl.T.Helper()
+		ctrl.T.Fatalf("wrong type of result %d of %T.%v: %T is not %v",
+			i, receiver, method, rets[i], reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return ret
+}
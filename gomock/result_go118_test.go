@@ -0,0 +1,49 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package gomock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestResult(t *testing.T) {
+	reporter, ctrl := createFixtures(t)
+	subject := new(Subject)
+	rets := []interface{}{3, errors.New("boom")}
+
+	if got := gomock.Result[int](ctrl, subject, "ErrorMethod", rets, 0); got != 3 {
+		t.Errorf("got result 0 %v, want 3", got)
+	}
+	if got := gomock.Result[error](ctrl, subject, "ErrorMethod", rets, 1); got == nil || got.Error() != "boom" {
+		t.Errorf("got result 1 %v, want boom", got)
+	}
+	if got := gomock.Result[error](ctrl, subject, "ErrorMethod", []interface{}{3, nil}, 1); got != nil {
+		t.Errorf("got nil result %v, want nil", got)
+	}
+	if got := gomock.Result[int](ctrl, subject, "ErrorMethod", nil, 0); got != 0 {
+		t.Errorf("got missing result %v, want 0", got)
+	}
+	reporter.assertPass("results of the right types")
+
+	reporter.assertFatal(func() {
+		gomock.Result[int](ctrl, subject, "ErrorMethod", []interface{}{"3", nil}, 0)
+	}, "wrong type of result 0 of *gomock_test.Subject.ErrorMethod: string is not int")
+}
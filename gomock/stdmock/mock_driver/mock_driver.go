@@ -0,0 +1,759 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: database/sql/driver (interfaces: Driver,Connector,Conn,Stmt,Tx,Rows,Result,Pinger,ConnBeginTx,ConnPrepareContext,ExecerContext,QueryerContext)
+
+// Package mock_driver is a generated GoMock package.
+package mock_driver
+
+import (
+	context "context"
+	driver "database/sql/driver"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDriver is a mock of Driver interface.
+type MockDriver struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriverMockRecorder
+}
+
+// MockDriverMockRecorder is the mock recorder for MockDriver.
+type MockDriverMockRecorder struct {
+	mock *MockDriver
+	stub bool
+}
+
+// NewMockDriver creates a new mock instance.
+func NewMockDriver(ctrl *gomock.Controller) *MockDriver {
+	mock := &MockDriver{ctrl: ctrl}
+	mock.recorder = &MockDriverMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriver) EXPECT() *MockDriverMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockDriver) STUB() *MockDriverMockRecorder {
+	return &MockDriverMockRecorder{mock: m, stub: true}
+}
+
+// Open mocks base method.
+func (m *MockDriver) Open(arg0 string) (driver.Conn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(driver.Conn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockDriverMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockDriver)(nil).Open), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockDriver)(nil).Open), arg0)
+}
+
+// MockConnector is a mock of Connector interface.
+type MockConnector struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectorMockRecorder
+}
+
+// MockConnectorMockRecorder is the mock recorder for MockConnector.
+type MockConnectorMockRecorder struct {
+	mock *MockConnector
+	stub bool
+}
+
+// NewMockConnector creates a new mock instance.
+func NewMockConnector(ctrl *gomock.Controller) *MockConnector {
+	mock := &MockConnector{ctrl: ctrl}
+	mock.recorder = &MockConnectorMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnector) EXPECT() *MockConnectorMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockConnector) STUB() *MockConnectorMockRecorder {
+	return &MockConnectorMockRecorder{mock: m, stub: true}
+}
+
+// Connect mocks base method.
+func (m *MockConnector) Connect(arg0 context.Context) (driver.Conn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", arg0)
+	ret0, _ := ret[0].(driver.Conn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockConnectorMockRecorder) Connect(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConnector)(nil).Connect), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConnector)(nil).Connect), arg0)
+}
+
+// Driver mocks base method.
+func (m *MockConnector) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *MockConnectorMockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Driver", reflect.TypeOf((*MockConnector)(nil).Driver))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*MockConnector)(nil).Driver))
+}
+
+// MockConn is a mock of Conn interface.
+type MockConn struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnMockRecorder
+}
+
+// MockConnMockRecorder is the mock recorder for MockConn.
+type MockConnMockRecorder struct {
+	mock *MockConn
+	stub bool
+}
+
+// NewMockConn creates a new mock instance.
+func NewMockConn(ctrl *gomock.Controller) *MockConn {
+	mock := &MockConn{ctrl: ctrl}
+	mock.recorder = &MockConnMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConn) EXPECT() *MockConnMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockConn) STUB() *MockConnMockRecorder {
+	return &MockConnMockRecorder{mock: m, stub: true}
+}
+
+// Begin mocks base method.
+func (m *MockConn) Begin() (driver.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin")
+	ret0, _ := ret[0].(driver.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockConnMockRecorder) Begin() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockConn)(nil).Begin))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockConn)(nil).Begin))
+}
+
+// Close mocks base method.
+func (m *MockConn) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockConnMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConn)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConn)(nil).Close))
+}
+
+// Prepare mocks base method.
+func (m *MockConn) Prepare(arg0 string) (driver.Stmt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prepare", arg0)
+	ret0, _ := ret[0].(driver.Stmt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prepare indicates an expected call of Prepare.
+func (mr *MockConnMockRecorder) Prepare(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockConn)(nil).Prepare), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockConn)(nil).Prepare), arg0)
+}
+
+// MockStmt is a mock of Stmt interface.
+type MockStmt struct {
+	ctrl     *gomock.Controller
+	recorder *MockStmtMockRecorder
+}
+
+// MockStmtMockRecorder is the mock recorder for MockStmt.
+type MockStmtMockRecorder struct {
+	mock *MockStmt
+	stub bool
+}
+
+// NewMockStmt creates a new mock instance.
+func NewMockStmt(ctrl *gomock.Controller) *MockStmt {
+	mock := &MockStmt{ctrl: ctrl}
+	mock.recorder = &MockStmtMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStmt) EXPECT() *MockStmtMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockStmt) STUB() *MockStmtMockRecorder {
+	return &MockStmtMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockStmt) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStmtMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStmt)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStmt)(nil).Close))
+}
+
+// Exec mocks base method.
+func (m *MockStmt) Exec(arg0 []driver.Value) (driver.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0)
+	ret0, _ := ret[0].(driver.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockStmtMockRecorder) Exec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockStmt)(nil).Exec), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockStmt)(nil).Exec), arg0)
+}
+
+// NumInput mocks base method.
+func (m *MockStmt) NumInput() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NumInput")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// NumInput indicates an expected call of NumInput.
+func (mr *MockStmtMockRecorder) NumInput() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "NumInput", reflect.TypeOf((*MockStmt)(nil).NumInput))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumInput", reflect.TypeOf((*MockStmt)(nil).NumInput))
+}
+
+// Query mocks base method.
+func (m *MockStmt) Query(arg0 []driver.Value) (driver.Rows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0)
+	ret0, _ := ret[0].(driver.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockStmtMockRecorder) Query(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockStmt)(nil).Query), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockStmt)(nil).Query), arg0)
+}
+
+// MockTx is a mock of Tx interface.
+type MockTx struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxMockRecorder
+}
+
+// MockTxMockRecorder is the mock recorder for MockTx.
+type MockTxMockRecorder struct {
+	mock *MockTx
+	stub bool
+}
+
+// NewMockTx creates a new mock instance.
+func NewMockTx(ctrl *gomock.Controller) *MockTx {
+	mock := &MockTx{ctrl: ctrl}
+	mock.recorder = &MockTxMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTx) EXPECT() *MockTxMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockTx) STUB() *MockTxMockRecorder {
+	return &MockTxMockRecorder{mock: m, stub: true}
+}
+
+// Commit mocks base method.
+func (m *MockTx) Commit() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockTxMockRecorder) Commit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTx)(nil).Commit))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTx)(nil).Commit))
+}
+
+// Rollback mocks base method.
+func (m *MockTx) Rollback() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockTxMockRecorder) Rollback() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTx)(nil).Rollback))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTx)(nil).Rollback))
+}
+
+// MockRows is a mock of Rows interface.
+type MockRows struct {
+	ctrl     *gomock.Controller
+	recorder *MockRowsMockRecorder
+}
+
+// MockRowsMockRecorder is the mock recorder for MockRows.
+type MockRowsMockRecorder struct {
+	mock *MockRows
+	stub bool
+}
+
+// NewMockRows creates a new mock instance.
+func NewMockRows(ctrl *gomock.Controller) *MockRows {
+	mock := &MockRows{ctrl: ctrl}
+	mock.recorder = &MockRowsMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRows) EXPECT() *MockRowsMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockRows) STUB() *MockRowsMockRecorder {
+	return &MockRowsMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockRows) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockRowsMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRows)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRows)(nil).Close))
+}
+
+// Columns mocks base method.
+func (m *MockRows) Columns() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Columns")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Columns indicates an expected call of Columns.
+func (mr *MockRowsMockRecorder) Columns() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Columns", reflect.TypeOf((*MockRows)(nil).Columns))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Columns", reflect.TypeOf((*MockRows)(nil).Columns))
+}
+
+// Next mocks base method.
+func (m *MockRows) Next(arg0 []driver.Value) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockRowsMockRecorder) Next(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockRows)(nil).Next), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockRows)(nil).Next), arg0)
+}
+
+// MockResult is a mock of Result interface.
+type MockResult struct {
+	ctrl     *gomock.Controller
+	recorder *MockResultMockRecorder
+}
+
+// MockResultMockRecorder is the mock recorder for MockResult.
+type MockResultMockRecorder struct {
+	mock *MockResult
+	stub bool
+}
+
+// NewMockResult creates a new mock instance.
+func NewMockResult(ctrl *gomock.Controller) *MockResult {
+	mock := &MockResult{ctrl: ctrl}
+	mock.recorder = &MockResultMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResult) EXPECT() *MockResultMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockResult) STUB() *MockResultMockRecorder {
+	return &MockResultMockRecorder{mock: m, stub: true}
+}
+
+// LastInsertId mocks base method.
+func (m *MockResult) LastInsertId() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastInsertId")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastInsertId indicates an expected call of LastInsertId.
+func (mr *MockResultMockRecorder) LastInsertId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "LastInsertId", reflect.TypeOf((*MockResult)(nil).LastInsertId))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastInsertId", reflect.TypeOf((*MockResult)(nil).LastInsertId))
+}
+
+// RowsAffected mocks base method.
+func (m *MockResult) RowsAffected() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RowsAffected")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RowsAffected indicates an expected call of RowsAffected.
+func (mr *MockResultMockRecorder) RowsAffected() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "RowsAffected", reflect.TypeOf((*MockResult)(nil).RowsAffected))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RowsAffected", reflect.TypeOf((*MockResult)(nil).RowsAffected))
+}
+
+// MockPinger is a mock of Pinger interface.
+type MockPinger struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingerMockRecorder
+}
+
+// MockPingerMockRecorder is the mock recorder for MockPinger.
+type MockPingerMockRecorder struct {
+	mock *MockPinger
+	stub bool
+}
+
+// NewMockPinger creates a new mock instance.
+func NewMockPinger(ctrl *gomock.Controller) *MockPinger {
+	mock := &MockPinger{ctrl: ctrl}
+	mock.recorder = &MockPingerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPinger) EXPECT() *MockPingerMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockPinger) STUB() *MockPingerMockRecorder {
+	return &MockPingerMockRecorder{mock: m, stub: true}
+}
+
+// Ping mocks base method.
+func (m *MockPinger) Ping(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockPingerMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockPinger)(nil).Ping), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockPinger)(nil).Ping), arg0)
+}
+
+// MockConnBeginTx is a mock of ConnBeginTx interface.
+type MockConnBeginTx struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnBeginTxMockRecorder
+}
+
+// MockConnBeginTxMockRecorder is the mock recorder for MockConnBeginTx.
+type MockConnBeginTxMockRecorder struct {
+	mock *MockConnBeginTx
+	stub bool
+}
+
+// NewMockConnBeginTx creates a new mock instance.
+func NewMockConnBeginTx(ctrl *gomock.Controller) *MockConnBeginTx {
+	mock := &MockConnBeginTx{ctrl: ctrl}
+	mock.recorder = &MockConnBeginTxMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnBeginTx) EXPECT() *MockConnBeginTxMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockConnBeginTx) STUB() *MockConnBeginTxMockRecorder {
+	return &MockConnBeginTxMockRecorder{mock: m, stub: true}
+}
+
+// BeginTx mocks base method.
+func (m *MockConnBeginTx) BeginTx(arg0 context.Context, arg1 driver.TxOptions) (driver.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTx", arg0, arg1)
+	ret0, _ := ret[0].(driver.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTx indicates an expected call of BeginTx.
+func (mr *MockConnBeginTxMockRecorder) BeginTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "BeginTx", reflect.TypeOf((*MockConnBeginTx)(nil).BeginTx), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTx", reflect.TypeOf((*MockConnBeginTx)(nil).BeginTx), arg0, arg1)
+}
+
+// MockConnPrepareContext is a mock of ConnPrepareContext interface.
+type MockConnPrepareContext struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnPrepareContextMockRecorder
+}
+
+// MockConnPrepareContextMockRecorder is the mock recorder for MockConnPrepareContext.
+type MockConnPrepareContextMockRecorder struct {
+	mock *MockConnPrepareContext
+	stub bool
+}
+
+// NewMockConnPrepareContext creates a new mock instance.
+func NewMockConnPrepareContext(ctrl *gomock.Controller) *MockConnPrepareContext {
+	mock := &MockConnPrepareContext{ctrl: ctrl}
+	mock.recorder = &MockConnPrepareContextMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnPrepareContext) EXPECT() *MockConnPrepareContextMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockConnPrepareContext) STUB() *MockConnPrepareContextMockRecorder {
+	return &MockConnPrepareContextMockRecorder{mock: m, stub: true}
+}
+
+// PrepareContext mocks base method.
+func (m *MockConnPrepareContext) PrepareContext(arg0 context.Context, arg1 string) (driver.Stmt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareContext", arg0, arg1)
+	ret0, _ := ret[0].(driver.Stmt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareContext indicates an expected call of PrepareContext.
+func (mr *MockConnPrepareContextMockRecorder) PrepareContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "PrepareContext", reflect.TypeOf((*MockConnPrepareContext)(nil).PrepareContext), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareContext", reflect.TypeOf((*MockConnPrepareContext)(nil).PrepareContext), arg0, arg1)
+}
+
+// MockExecerContext is a mock of ExecerContext interface.
+type MockExecerContext struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecerContextMockRecorder
+}
+
+// MockExecerContextMockRecorder is the mock recorder for MockExecerContext.
+type MockExecerContextMockRecorder struct {
+	mock *MockExecerContext
+	stub bool
+}
+
+// NewMockExecerContext creates a new mock instance.
+func NewMockExecerContext(ctrl *gomock.Controller) *MockExecerContext {
+	mock := &MockExecerContext{ctrl: ctrl}
+	mock.recorder = &MockExecerContextMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecerContext) EXPECT() *MockExecerContextMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockExecerContext) STUB() *MockExecerContextMockRecorder {
+	return &MockExecerContextMockRecorder{mock: m, stub: true}
+}
+
+// ExecContext mocks base method.
+func (m *MockExecerContext) ExecContext(arg0 context.Context, arg1 string, arg2 []driver.NamedValue) (driver.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(driver.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContext indicates an expected call of ExecContext.
+func (mr *MockExecerContextMockRecorder) ExecContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockExecerContext)(nil).ExecContext), arg0, arg1, arg2)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockExecerContext)(nil).ExecContext), arg0, arg1, arg2)
+}
+
+// MockQueryerContext is a mock of QueryerContext interface.
+type MockQueryerContext struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueryerContextMockRecorder
+}
+
+// MockQueryerContextMockRecorder is the mock recorder for MockQueryerContext.
+type MockQueryerContextMockRecorder struct {
+	mock *MockQueryerContext
+	stub bool
+}
+
+// NewMockQueryerContext creates a new mock instance.
+func NewMockQueryerContext(ctrl *gomock.Controller) *MockQueryerContext {
+	mock := &MockQueryerContext{ctrl: ctrl}
+	mock.recorder = &MockQueryerContextMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryerContext) EXPECT() *MockQueryerContextMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockQueryerContext) STUB() *MockQueryerContextMockRecorder {
+	return &MockQueryerContextMockRecorder{mock: m, stub: true}
+}
+
+// QueryContext mocks base method.
+func (m *MockQueryerContext) QueryContext(arg0 context.Context, arg1 string, arg2 []driver.NamedValue) (driver.Rows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(driver.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryContext indicates an expected call of QueryContext.
+func (mr *MockQueryerContextMockRecorder) QueryContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "QueryContext", reflect.TypeOf((*MockQueryerContext)(nil).QueryContext), arg0, arg1, arg2)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContext", reflect.TypeOf((*MockQueryerContext)(nil).QueryContext), arg0, arg1, arg2)
+}
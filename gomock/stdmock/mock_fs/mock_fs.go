@@ -0,0 +1,809 @@
+//go:build go1.16
+// +build go1.16
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: io/fs (interfaces: FS,File,DirEntry,FileInfo,ReadDirFile,ReadDirFS,ReadFileFS,StatFS,GlobFS,SubFS)
+
+// Package mock_fs is a generated GoMock package.
+package mock_fs
+
+import (
+	fs "io/fs"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFS is a mock of FS interface.
+type MockFS struct {
+	ctrl     *gomock.Controller
+	recorder *MockFSMockRecorder
+}
+
+// MockFSMockRecorder is the mock recorder for MockFS.
+type MockFSMockRecorder struct {
+	mock *MockFS
+	stub bool
+}
+
+// NewMockFS creates a new mock instance.
+func NewMockFS(ctrl *gomock.Controller) *MockFS {
+	mock := &MockFS{ctrl: ctrl}
+	mock.recorder = &MockFSMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFS) EXPECT() *MockFSMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockFS) STUB() *MockFSMockRecorder {
+	return &MockFSMockRecorder{mock: m, stub: true}
+}
+
+// Open mocks base method.
+func (m *MockFS) Open(arg0 string) (fs.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(fs.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockFSMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockFS)(nil).Open), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockFS)(nil).Open), arg0)
+}
+
+// MockFile is a mock of File interface.
+type MockFile struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileMockRecorder
+}
+
+// MockFileMockRecorder is the mock recorder for MockFile.
+type MockFileMockRecorder struct {
+	mock *MockFile
+	stub bool
+}
+
+// NewMockFile creates a new mock instance.
+func NewMockFile(ctrl *gomock.Controller) *MockFile {
+	mock := &MockFile{ctrl: ctrl}
+	mock.recorder = &MockFileMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFile) EXPECT() *MockFileMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockFile) STUB() *MockFileMockRecorder {
+	return &MockFileMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockFile) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockFileMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockFile)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockFile)(nil).Close))
+}
+
+// Read mocks base method.
+func (m *MockFile) Read(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockFileMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockFile)(nil).Read), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockFile)(nil).Read), arg0)
+}
+
+// Stat mocks base method.
+func (m *MockFile) Stat() (fs.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stat")
+	ret0, _ := ret[0].(fs.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stat indicates an expected call of Stat.
+func (mr *MockFileMockRecorder) Stat() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockFile)(nil).Stat))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockFile)(nil).Stat))
+}
+
+// MockDirEntry is a mock of DirEntry interface.
+type MockDirEntry struct {
+	ctrl     *gomock.Controller
+	recorder *MockDirEntryMockRecorder
+}
+
+// MockDirEntryMockRecorder is the mock recorder for MockDirEntry.
+type MockDirEntryMockRecorder struct {
+	mock *MockDirEntry
+	stub bool
+}
+
+// NewMockDirEntry creates a new mock instance.
+func NewMockDirEntry(ctrl *gomock.Controller) *MockDirEntry {
+	mock := &MockDirEntry{ctrl: ctrl}
+	mock.recorder = &MockDirEntryMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDirEntry) EXPECT() *MockDirEntryMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockDirEntry) STUB() *MockDirEntryMockRecorder {
+	return &MockDirEntryMockRecorder{mock: m, stub: true}
+}
+
+// Info mocks base method.
+func (m *MockDirEntry) Info() (fs.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Info")
+	ret0, _ := ret[0].(fs.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Info indicates an expected call of Info.
+func (mr *MockDirEntryMockRecorder) Info() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockDirEntry)(nil).Info))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockDirEntry)(nil).Info))
+}
+
+// IsDir mocks base method.
+func (m *MockDirEntry) IsDir() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDir")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDir indicates an expected call of IsDir.
+func (mr *MockDirEntryMockRecorder) IsDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "IsDir", reflect.TypeOf((*MockDirEntry)(nil).IsDir))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDir", reflect.TypeOf((*MockDirEntry)(nil).IsDir))
+}
+
+// Name mocks base method.
+func (m *MockDirEntry) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockDirEntryMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockDirEntry)(nil).Name))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockDirEntry)(nil).Name))
+}
+
+// Type mocks base method.
+func (m *MockDirEntry) Type() fs.FileMode {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type")
+	ret0, _ := ret[0].(fs.FileMode)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockDirEntryMockRecorder) Type() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockDirEntry)(nil).Type))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockDirEntry)(nil).Type))
+}
+
+// MockFileInfo is a mock of FileInfo interface.
+type MockFileInfo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileInfoMockRecorder
+}
+
+// MockFileInfoMockRecorder is the mock recorder for MockFileInfo.
+type MockFileInfoMockRecorder struct {
+	mock *MockFileInfo
+	stub bool
+}
+
+// NewMockFileInfo creates a new mock instance.
+func NewMockFileInfo(ctrl *gomock.Controller) *MockFileInfo {
+	mock := &MockFileInfo{ctrl: ctrl}
+	mock.recorder = &MockFileInfoMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileInfo) EXPECT() *MockFileInfoMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockFileInfo) STUB() *MockFileInfoMockRecorder {
+	return &MockFileInfoMockRecorder{mock: m, stub: true}
+}
+
+// IsDir mocks base method.
+func (m *MockFileInfo) IsDir() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDir")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDir indicates an expected call of IsDir.
+func (mr *MockFileInfoMockRecorder) IsDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "IsDir", reflect.TypeOf((*MockFileInfo)(nil).IsDir))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDir", reflect.TypeOf((*MockFileInfo)(nil).IsDir))
+}
+
+// ModTime mocks base method.
+func (m *MockFileInfo) ModTime() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModTime")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// ModTime indicates an expected call of ModTime.
+func (mr *MockFileInfoMockRecorder) ModTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ModTime", reflect.TypeOf((*MockFileInfo)(nil).ModTime))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModTime", reflect.TypeOf((*MockFileInfo)(nil).ModTime))
+}
+
+// Mode mocks base method.
+func (m *MockFileInfo) Mode() fs.FileMode {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mode")
+	ret0, _ := ret[0].(fs.FileMode)
+	return ret0
+}
+
+// Mode indicates an expected call of Mode.
+func (mr *MockFileInfoMockRecorder) Mode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Mode", reflect.TypeOf((*MockFileInfo)(nil).Mode))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mode", reflect.TypeOf((*MockFileInfo)(nil).Mode))
+}
+
+// Name mocks base method.
+func (m *MockFileInfo) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockFileInfoMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockFileInfo)(nil).Name))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockFileInfo)(nil).Name))
+}
+
+// Size mocks base method.
+func (m *MockFileInfo) Size() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockFileInfoMockRecorder) Size() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockFileInfo)(nil).Size))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockFileInfo)(nil).Size))
+}
+
+// Sys mocks base method.
+func (m *MockFileInfo) Sys() interface{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sys")
+	ret0, _ := ret[0].(interface{})
+	return ret0
+}
+
+// Sys indicates an expected call of Sys.
+func (mr *MockFileInfoMockRecorder) Sys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Sys", reflect.TypeOf((*MockFileInfo)(nil).Sys))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sys", reflect.TypeOf((*MockFileInfo)(nil).Sys))
+}
+
+// MockReadDirFile is a mock of ReadDirFile interface.
+type MockReadDirFile struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadDirFileMockRecorder
+}
+
+// MockReadDirFileMockRecorder is the mock recorder for MockReadDirFile.
+type MockReadDirFileMockRecorder struct {
+	mock *MockReadDirFile
+	stub bool
+}
+
+// NewMockReadDirFile creates a new mock instance.
+func NewMockReadDirFile(ctrl *gomock.Controller) *MockReadDirFile {
+	mock := &MockReadDirFile{ctrl: ctrl}
+	mock.recorder = &MockReadDirFileMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadDirFile) EXPECT() *MockReadDirFileMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadDirFile) STUB() *MockReadDirFileMockRecorder {
+	return &MockReadDirFileMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockReadDirFile) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockReadDirFileMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadDirFile)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadDirFile)(nil).Close))
+}
+
+// Read mocks base method.
+func (m *MockReadDirFile) Read(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReadDirFileMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadDirFile)(nil).Read), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadDirFile)(nil).Read), arg0)
+}
+
+// ReadDir mocks base method.
+func (m *MockReadDirFile) ReadDir(arg0 int) ([]fs.DirEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDir", arg0)
+	ret0, _ := ret[0].([]fs.DirEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDir indicates an expected call of ReadDir.
+func (mr *MockReadDirFileMockRecorder) ReadDir(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ReadDir", reflect.TypeOf((*MockReadDirFile)(nil).ReadDir), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDir", reflect.TypeOf((*MockReadDirFile)(nil).ReadDir), arg0)
+}
+
+// Stat mocks base method.
+func (m *MockReadDirFile) Stat() (fs.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stat")
+	ret0, _ := ret[0].(fs.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stat indicates an expected call of Stat.
+func (mr *MockReadDirFileMockRecorder) Stat() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockReadDirFile)(nil).Stat))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockReadDirFile)(nil).Stat))
+}
+
+// MockReadDirFS is a mock of ReadDirFS interface.
+type MockReadDirFS struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadDirFSMockRecorder
+}
+
+// MockReadDirFSMockRecorder is the mock recorder for MockReadDirFS.
+type MockReadDirFSMockRecorder struct {
+	mock *MockReadDirFS
+	stub bool
+}
+
+// NewMockReadDirFS creates a new mock instance.
+func NewMockReadDirFS(ctrl *gomock.Controller) *MockReadDirFS {
+	mock := &MockReadDirFS{ctrl: ctrl}
+	mock.recorder = &MockReadDirFSMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadDirFS) EXPECT() *MockReadDirFSMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadDirFS) STUB() *MockReadDirFSMockRecorder {
+	return &MockReadDirFSMockRecorder{mock: m, stub: true}
+}
+
+// Open mocks base method.
+func (m *MockReadDirFS) Open(arg0 string) (fs.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(fs.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockReadDirFSMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockReadDirFS)(nil).Open), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockReadDirFS)(nil).Open), arg0)
+}
+
+// ReadDir mocks base method.
+func (m *MockReadDirFS) ReadDir(arg0 string) ([]fs.DirEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDir", arg0)
+	ret0, _ := ret[0].([]fs.DirEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDir indicates an expected call of ReadDir.
+func (mr *MockReadDirFSMockRecorder) ReadDir(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ReadDir", reflect.TypeOf((*MockReadDirFS)(nil).ReadDir), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDir", reflect.TypeOf((*MockReadDirFS)(nil).ReadDir), arg0)
+}
+
+// MockReadFileFS is a mock of ReadFileFS interface.
+type MockReadFileFS struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadFileFSMockRecorder
+}
+
+// MockReadFileFSMockRecorder is the mock recorder for MockReadFileFS.
+type MockReadFileFSMockRecorder struct {
+	mock *MockReadFileFS
+	stub bool
+}
+
+// NewMockReadFileFS creates a new mock instance.
+func NewMockReadFileFS(ctrl *gomock.Controller) *MockReadFileFS {
+	mock := &MockReadFileFS{ctrl: ctrl}
+	mock.recorder = &MockReadFileFSMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadFileFS) EXPECT() *MockReadFileFSMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadFileFS) STUB() *MockReadFileFSMockRecorder {
+	return &MockReadFileFSMockRecorder{mock: m, stub: true}
+}
+
+// Open mocks base method.
+func (m *MockReadFileFS) Open(arg0 string) (fs.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(fs.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockReadFileFSMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockReadFileFS)(nil).Open), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockReadFileFS)(nil).Open), arg0)
+}
+
+// ReadFile mocks base method.
+func (m *MockReadFileFS) ReadFile(arg0 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockReadFileFSMockRecorder) ReadFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockReadFileFS)(nil).ReadFile), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockReadFileFS)(nil).ReadFile), arg0)
+}
+
+// MockStatFS is a mock of StatFS interface.
+type MockStatFS struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatFSMockRecorder
+}
+
+// MockStatFSMockRecorder is the mock recorder for MockStatFS.
+type MockStatFSMockRecorder struct {
+	mock *MockStatFS
+	stub bool
+}
+
+// NewMockStatFS creates a new mock instance.
+func NewMockStatFS(ctrl *gomock.Controller) *MockStatFS {
+	mock := &MockStatFS{ctrl: ctrl}
+	mock.recorder = &MockStatFSMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatFS) EXPECT() *MockStatFSMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockStatFS) STUB() *MockStatFSMockRecorder {
+	return &MockStatFSMockRecorder{mock: m, stub: true}
+}
+
+// Open mocks base method.
+func (m *MockStatFS) Open(arg0 string) (fs.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(fs.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockStatFSMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockStatFS)(nil).Open), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockStatFS)(nil).Open), arg0)
+}
+
+// Stat mocks base method.
+func (m *MockStatFS) Stat(arg0 string) (fs.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stat", arg0)
+	ret0, _ := ret[0].(fs.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stat indicates an expected call of Stat.
+func (mr *MockStatFSMockRecorder) Stat(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockStatFS)(nil).Stat), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockStatFS)(nil).Stat), arg0)
+}
+
+// MockGlobFS is a mock of GlobFS interface.
+type MockGlobFS struct {
+	ctrl     *gomock.Controller
+	recorder *MockGlobFSMockRecorder
+}
+
+// MockGlobFSMockRecorder is the mock recorder for MockGlobFS.
+type MockGlobFSMockRecorder struct {
+	mock *MockGlobFS
+	stub bool
+}
+
+// NewMockGlobFS creates a new mock instance.
+func NewMockGlobFS(ctrl *gomock.Controller) *MockGlobFS {
+	mock := &MockGlobFS{ctrl: ctrl}
+	mock.recorder = &MockGlobFSMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGlobFS) EXPECT() *MockGlobFSMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockGlobFS) STUB() *MockGlobFSMockRecorder {
+	return &MockGlobFSMockRecorder{mock: m, stub: true}
+}
+
+// Glob mocks base method.
+func (m *MockGlobFS) Glob(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Glob", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Glob indicates an expected call of Glob.
+func (mr *MockGlobFSMockRecorder) Glob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Glob", reflect.TypeOf((*MockGlobFS)(nil).Glob), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Glob", reflect.TypeOf((*MockGlobFS)(nil).Glob), arg0)
+}
+
+// Open mocks base method.
+func (m *MockGlobFS) Open(arg0 string) (fs.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(fs.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockGlobFSMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockGlobFS)(nil).Open), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockGlobFS)(nil).Open), arg0)
+}
+
+// MockSubFS is a mock of SubFS interface.
+type MockSubFS struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubFSMockRecorder
+}
+
+// MockSubFSMockRecorder is the mock recorder for MockSubFS.
+type MockSubFSMockRecorder struct {
+	mock *MockSubFS
+	stub bool
+}
+
+// NewMockSubFS creates a new mock instance.
+func NewMockSubFS(ctrl *gomock.Controller) *MockSubFS {
+	mock := &MockSubFS{ctrl: ctrl}
+	mock.recorder = &MockSubFSMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubFS) EXPECT() *MockSubFSMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockSubFS) STUB() *MockSubFSMockRecorder {
+	return &MockSubFSMockRecorder{mock: m, stub: true}
+}
+
+// Open mocks base method.
+func (m *MockSubFS) Open(arg0 string) (fs.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(fs.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockSubFSMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockSubFS)(nil).Open), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockSubFS)(nil).Open), arg0)
+}
+
+// Sub mocks base method.
+func (m *MockSubFS) Sub(arg0 string) (fs.FS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sub", arg0)
+	ret0, _ := ret[0].(fs.FS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sub indicates an expected call of Sub.
+func (mr *MockSubFSMockRecorder) Sub(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Sub", reflect.TypeOf((*MockSubFS)(nil).Sub), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sub", reflect.TypeOf((*MockSubFS)(nil).Sub), arg0)
+}
@@ -0,0 +1,344 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: net/http (interfaces: RoundTripper,Handler,ResponseWriter,Flusher,Hijacker,CookieJar)
+
+// Package mock_http is a generated GoMock package.
+package mock_http
+
+import (
+	bufio "bufio"
+	net "net"
+	http "net/http"
+	url "net/url"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRoundTripper is a mock of RoundTripper interface.
+type MockRoundTripper struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoundTripperMockRecorder
+}
+
+// MockRoundTripperMockRecorder is the mock recorder for MockRoundTripper.
+type MockRoundTripperMockRecorder struct {
+	mock *MockRoundTripper
+	stub bool
+}
+
+// NewMockRoundTripper creates a new mock instance.
+func NewMockRoundTripper(ctrl *gomock.Controller) *MockRoundTripper {
+	mock := &MockRoundTripper{ctrl: ctrl}
+	mock.recorder = &MockRoundTripperMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoundTripper) EXPECT() *MockRoundTripperMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockRoundTripper) STUB() *MockRoundTripperMockRecorder {
+	return &MockRoundTripperMockRecorder{mock: m, stub: true}
+}
+
+// RoundTrip mocks base method.
+func (m *MockRoundTripper) RoundTrip(arg0 *http.Request) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoundTrip", arg0)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoundTrip indicates an expected call of RoundTrip.
+func (mr *MockRoundTripperMockRecorder) RoundTrip(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "RoundTrip", reflect.TypeOf((*MockRoundTripper)(nil).RoundTrip), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoundTrip", reflect.TypeOf((*MockRoundTripper)(nil).RoundTrip), arg0)
+}
+
+// MockHandler is a mock of Handler interface.
+type MockHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandlerMockRecorder
+}
+
+// MockHandlerMockRecorder is the mock recorder for MockHandler.
+type MockHandlerMockRecorder struct {
+	mock *MockHandler
+	stub bool
+}
+
+// NewMockHandler creates a new mock instance.
+func NewMockHandler(ctrl *gomock.Controller) *MockHandler {
+	mock := &MockHandler{ctrl: ctrl}
+	mock.recorder = &MockHandlerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockHandler) STUB() *MockHandlerMockRecorder {
+	return &MockHandlerMockRecorder{mock: m, stub: true}
+}
+
+// ServeHTTP mocks base method.
+func (m *MockHandler) ServeHTTP(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", arg0, arg1)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockHandlerMockRecorder) ServeHTTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockHandler)(nil).ServeHTTP), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockHandler)(nil).ServeHTTP), arg0, arg1)
+}
+
+// MockResponseWriter is a mock of ResponseWriter interface.
+type MockResponseWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockResponseWriterMockRecorder
+}
+
+// MockResponseWriterMockRecorder is the mock recorder for MockResponseWriter.
+type MockResponseWriterMockRecorder struct {
+	mock *MockResponseWriter
+	stub bool
+}
+
+// NewMockResponseWriter creates a new mock instance.
+func NewMockResponseWriter(ctrl *gomock.Controller) *MockResponseWriter {
+	mock := &MockResponseWriter{ctrl: ctrl}
+	mock.recorder = &MockResponseWriterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResponseWriter) EXPECT() *MockResponseWriterMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockResponseWriter) STUB() *MockResponseWriterMockRecorder {
+	return &MockResponseWriterMockRecorder{mock: m, stub: true}
+}
+
+// Header mocks base method.
+func (m *MockResponseWriter) Header() http.Header {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(http.Header)
+	return ret0
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockResponseWriterMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockResponseWriter)(nil).Header))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockResponseWriter)(nil).Header))
+}
+
+// Write mocks base method.
+func (m *MockResponseWriter) Write(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockResponseWriterMockRecorder) Write(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockResponseWriter)(nil).Write), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockResponseWriter)(nil).Write), arg0)
+}
+
+// WriteHeader mocks base method.
+func (m *MockResponseWriter) WriteHeader(arg0 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WriteHeader", arg0)
+}
+
+// WriteHeader indicates an expected call of WriteHeader.
+func (mr *MockResponseWriterMockRecorder) WriteHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "WriteHeader", reflect.TypeOf((*MockResponseWriter)(nil).WriteHeader), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteHeader", reflect.TypeOf((*MockResponseWriter)(nil).WriteHeader), arg0)
+}
+
+// MockFlusher is a mock of Flusher interface.
+type MockFlusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlusherMockRecorder
+}
+
+// MockFlusherMockRecorder is the mock recorder for MockFlusher.
+type MockFlusherMockRecorder struct {
+	mock *MockFlusher
+	stub bool
+}
+
+// NewMockFlusher creates a new mock instance.
+func NewMockFlusher(ctrl *gomock.Controller) *MockFlusher {
+	mock := &MockFlusher{ctrl: ctrl}
+	mock.recorder = &MockFlusherMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFlusher) EXPECT() *MockFlusherMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockFlusher) STUB() *MockFlusherMockRecorder {
+	return &MockFlusherMockRecorder{mock: m, stub: true}
+}
+
+// Flush mocks base method.
+func (m *MockFlusher) Flush() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Flush")
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockFlusherMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockFlusher)(nil).Flush))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockFlusher)(nil).Flush))
+}
+
+// MockHijacker is a mock of Hijacker interface.
+type MockHijacker struct {
+	ctrl     *gomock.Controller
+	recorder *MockHijackerMockRecorder
+}
+
+// MockHijackerMockRecorder is the mock recorder for MockHijacker.
+type MockHijackerMockRecorder struct {
+	mock *MockHijacker
+	stub bool
+}
+
+// NewMockHijacker creates a new mock instance.
+func NewMockHijacker(ctrl *gomock.Controller) *MockHijacker {
+	mock := &MockHijacker{ctrl: ctrl}
+	mock.recorder = &MockHijackerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHijacker) EXPECT() *MockHijackerMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockHijacker) STUB() *MockHijackerMockRecorder {
+	return &MockHijackerMockRecorder{mock: m, stub: true}
+}
+
+// Hijack mocks base method.
+func (m *MockHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hijack")
+	ret0, _ := ret[0].(net.Conn)
+	ret1, _ := ret[1].(*bufio.ReadWriter)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Hijack indicates an expected call of Hijack.
+func (mr *MockHijackerMockRecorder) Hijack() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Hijack", reflect.TypeOf((*MockHijacker)(nil).Hijack))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hijack", reflect.TypeOf((*MockHijacker)(nil).Hijack))
+}
+
+// MockCookieJar is a mock of CookieJar interface.
+type MockCookieJar struct {
+	ctrl     *gomock.Controller
+	recorder *MockCookieJarMockRecorder
+}
+
+// MockCookieJarMockRecorder is the mock recorder for MockCookieJar.
+type MockCookieJarMockRecorder struct {
+	mock *MockCookieJar
+	stub bool
+}
+
+// NewMockCookieJar creates a new mock instance.
+func NewMockCookieJar(ctrl *gomock.Controller) *MockCookieJar {
+	mock := &MockCookieJar{ctrl: ctrl}
+	mock.recorder = &MockCookieJarMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCookieJar) EXPECT() *MockCookieJarMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockCookieJar) STUB() *MockCookieJarMockRecorder {
+	return &MockCookieJarMockRecorder{mock: m, stub: true}
+}
+
+// Cookies mocks base method.
+func (m *MockCookieJar) Cookies(arg0 *url.URL) []*http.Cookie {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cookies", arg0)
+	ret0, _ := ret[0].([]*http.Cookie)
+	return ret0
+}
+
+// Cookies indicates an expected call of Cookies.
+func (mr *MockCookieJarMockRecorder) Cookies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Cookies", reflect.TypeOf((*MockCookieJar)(nil).Cookies), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cookies", reflect.TypeOf((*MockCookieJar)(nil).Cookies), arg0)
+}
+
+// SetCookies mocks base method.
+func (m *MockCookieJar) SetCookies(arg0 *url.URL, arg1 []*http.Cookie) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCookies", arg0, arg1)
+}
+
+// SetCookies indicates an expected call of SetCookies.
+func (mr *MockCookieJarMockRecorder) SetCookies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "SetCookies", reflect.TypeOf((*MockCookieJar)(nil).SetCookies), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCookies", reflect.TypeOf((*MockCookieJar)(nil).SetCookies), arg0, arg1)
+}
@@ -0,0 +1,691 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: io (interfaces: Reader,Writer,Closer,Seeker,ReaderAt,WriterAt,StringWriter,ReadCloser,WriteCloser,ReadWriter,ReadWriteCloser,ReadSeeker)
+
+// Package mock_io is a generated GoMock package.
+package mock_io
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock *MockReader
+	stub bool
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReader) STUB() *MockReaderMockRecorder {
+	return &MockReaderMockRecorder{mock: m, stub: true}
+}
+
+// Read mocks base method.
+func (m *MockReader) Read(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReaderMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReader)(nil).Read), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReader)(nil).Read), arg0)
+}
+
+// MockWriter is a mock of Writer interface.
+type MockWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWriterMockRecorder
+}
+
+// MockWriterMockRecorder is the mock recorder for MockWriter.
+type MockWriterMockRecorder struct {
+	mock *MockWriter
+	stub bool
+}
+
+// NewMockWriter creates a new mock instance.
+func NewMockWriter(ctrl *gomock.Controller) *MockWriter {
+	mock := &MockWriter{ctrl: ctrl}
+	mock.recorder = &MockWriterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriter) EXPECT() *MockWriterMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockWriter) STUB() *MockWriterMockRecorder {
+	return &MockWriterMockRecorder{mock: m, stub: true}
+}
+
+// Write mocks base method.
+func (m *MockWriter) Write(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockWriterMockRecorder) Write(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockWriter)(nil).Write), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockWriter)(nil).Write), arg0)
+}
+
+// MockCloser is a mock of Closer interface.
+type MockCloser struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloserMockRecorder
+}
+
+// MockCloserMockRecorder is the mock recorder for MockCloser.
+type MockCloserMockRecorder struct {
+	mock *MockCloser
+	stub bool
+}
+
+// NewMockCloser creates a new mock instance.
+func NewMockCloser(ctrl *gomock.Controller) *MockCloser {
+	mock := &MockCloser{ctrl: ctrl}
+	mock.recorder = &MockCloserMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloser) EXPECT() *MockCloserMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockCloser) STUB() *MockCloserMockRecorder {
+	return &MockCloserMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockCloser) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockCloserMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCloser)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCloser)(nil).Close))
+}
+
+// MockSeeker is a mock of Seeker interface.
+type MockSeeker struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeekerMockRecorder
+}
+
+// MockSeekerMockRecorder is the mock recorder for MockSeeker.
+type MockSeekerMockRecorder struct {
+	mock *MockSeeker
+	stub bool
+}
+
+// NewMockSeeker creates a new mock instance.
+func NewMockSeeker(ctrl *gomock.Controller) *MockSeeker {
+	mock := &MockSeeker{ctrl: ctrl}
+	mock.recorder = &MockSeekerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeeker) EXPECT() *MockSeekerMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockSeeker) STUB() *MockSeekerMockRecorder {
+	return &MockSeekerMockRecorder{mock: m, stub: true}
+}
+
+// Seek mocks base method.
+func (m *MockSeeker) Seek(arg0 int64, arg1 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seek", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seek indicates an expected call of Seek.
+func (mr *MockSeekerMockRecorder) Seek(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockSeeker)(nil).Seek), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockSeeker)(nil).Seek), arg0, arg1)
+}
+
+// MockReaderAt is a mock of ReaderAt interface.
+type MockReaderAt struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderAtMockRecorder
+}
+
+// MockReaderAtMockRecorder is the mock recorder for MockReaderAt.
+type MockReaderAtMockRecorder struct {
+	mock *MockReaderAt
+	stub bool
+}
+
+// NewMockReaderAt creates a new mock instance.
+func NewMockReaderAt(ctrl *gomock.Controller) *MockReaderAt {
+	mock := &MockReaderAt{ctrl: ctrl}
+	mock.recorder = &MockReaderAtMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReaderAt) EXPECT() *MockReaderAtMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReaderAt) STUB() *MockReaderAtMockRecorder {
+	return &MockReaderAtMockRecorder{mock: m, stub: true}
+}
+
+// ReadAt mocks base method.
+func (m *MockReaderAt) ReadAt(arg0 []byte, arg1 int64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAt", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAt indicates an expected call of ReadAt.
+func (mr *MockReaderAtMockRecorder) ReadAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ReadAt", reflect.TypeOf((*MockReaderAt)(nil).ReadAt), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAt", reflect.TypeOf((*MockReaderAt)(nil).ReadAt), arg0, arg1)
+}
+
+// MockWriterAt is a mock of WriterAt interface.
+type MockWriterAt struct {
+	ctrl     *gomock.Controller
+	recorder *MockWriterAtMockRecorder
+}
+
+// MockWriterAtMockRecorder is the mock recorder for MockWriterAt.
+type MockWriterAtMockRecorder struct {
+	mock *MockWriterAt
+	stub bool
+}
+
+// NewMockWriterAt creates a new mock instance.
+func NewMockWriterAt(ctrl *gomock.Controller) *MockWriterAt {
+	mock := &MockWriterAt{ctrl: ctrl}
+	mock.recorder = &MockWriterAtMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriterAt) EXPECT() *MockWriterAtMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockWriterAt) STUB() *MockWriterAtMockRecorder {
+	return &MockWriterAtMockRecorder{mock: m, stub: true}
+}
+
+// WriteAt mocks base method.
+func (m *MockWriterAt) WriteAt(arg0 []byte, arg1 int64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteAt", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteAt indicates an expected call of WriteAt.
+func (mr *MockWriterAtMockRecorder) WriteAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "WriteAt", reflect.TypeOf((*MockWriterAt)(nil).WriteAt), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAt", reflect.TypeOf((*MockWriterAt)(nil).WriteAt), arg0, arg1)
+}
+
+// MockStringWriter is a mock of StringWriter interface.
+type MockStringWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockStringWriterMockRecorder
+}
+
+// MockStringWriterMockRecorder is the mock recorder for MockStringWriter.
+type MockStringWriterMockRecorder struct {
+	mock *MockStringWriter
+	stub bool
+}
+
+// NewMockStringWriter creates a new mock instance.
+func NewMockStringWriter(ctrl *gomock.Controller) *MockStringWriter {
+	mock := &MockStringWriter{ctrl: ctrl}
+	mock.recorder = &MockStringWriterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStringWriter) EXPECT() *MockStringWriterMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockStringWriter) STUB() *MockStringWriterMockRecorder {
+	return &MockStringWriterMockRecorder{mock: m, stub: true}
+}
+
+// WriteString mocks base method.
+func (m *MockStringWriter) WriteString(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteString", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteString indicates an expected call of WriteString.
+func (mr *MockStringWriterMockRecorder) WriteString(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "WriteString", reflect.TypeOf((*MockStringWriter)(nil).WriteString), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteString", reflect.TypeOf((*MockStringWriter)(nil).WriteString), arg0)
+}
+
+// MockReadCloser is a mock of ReadCloser interface.
+type MockReadCloser struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadCloserMockRecorder
+}
+
+// MockReadCloserMockRecorder is the mock recorder for MockReadCloser.
+type MockReadCloserMockRecorder struct {
+	mock *MockReadCloser
+	stub bool
+}
+
+// NewMockReadCloser creates a new mock instance.
+func NewMockReadCloser(ctrl *gomock.Controller) *MockReadCloser {
+	mock := &MockReadCloser{ctrl: ctrl}
+	mock.recorder = &MockReadCloserMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadCloser) EXPECT() *MockReadCloserMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadCloser) STUB() *MockReadCloserMockRecorder {
+	return &MockReadCloserMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockReadCloser) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockReadCloserMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadCloser)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadCloser)(nil).Close))
+}
+
+// Read mocks base method.
+func (m *MockReadCloser) Read(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReadCloserMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadCloser)(nil).Read), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadCloser)(nil).Read), arg0)
+}
+
+// MockWriteCloser is a mock of WriteCloser interface.
+type MockWriteCloser struct {
+	ctrl     *gomock.Controller
+	recorder *MockWriteCloserMockRecorder
+}
+
+// MockWriteCloserMockRecorder is the mock recorder for MockWriteCloser.
+type MockWriteCloserMockRecorder struct {
+	mock *MockWriteCloser
+	stub bool
+}
+
+// NewMockWriteCloser creates a new mock instance.
+func NewMockWriteCloser(ctrl *gomock.Controller) *MockWriteCloser {
+	mock := &MockWriteCloser{ctrl: ctrl}
+	mock.recorder = &MockWriteCloserMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriteCloser) EXPECT() *MockWriteCloserMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockWriteCloser) STUB() *MockWriteCloserMockRecorder {
+	return &MockWriteCloserMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockWriteCloser) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWriteCloserMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWriteCloser)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWriteCloser)(nil).Close))
+}
+
+// Write mocks base method.
+func (m *MockWriteCloser) Write(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockWriteCloserMockRecorder) Write(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockWriteCloser)(nil).Write), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockWriteCloser)(nil).Write), arg0)
+}
+
+// MockReadWriter is a mock of ReadWriter interface.
+type MockReadWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadWriterMockRecorder
+}
+
+// MockReadWriterMockRecorder is the mock recorder for MockReadWriter.
+type MockReadWriterMockRecorder struct {
+	mock *MockReadWriter
+	stub bool
+}
+
+// NewMockReadWriter creates a new mock instance.
+func NewMockReadWriter(ctrl *gomock.Controller) *MockReadWriter {
+	mock := &MockReadWriter{ctrl: ctrl}
+	mock.recorder = &MockReadWriterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadWriter) EXPECT() *MockReadWriterMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadWriter) STUB() *MockReadWriterMockRecorder {
+	return &MockReadWriterMockRecorder{mock: m, stub: true}
+}
+
+// Read mocks base method.
+func (m *MockReadWriter) Read(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReadWriterMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadWriter)(nil).Read), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadWriter)(nil).Read), arg0)
+}
+
+// Write mocks base method.
+func (m *MockReadWriter) Write(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockReadWriterMockRecorder) Write(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockReadWriter)(nil).Write), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockReadWriter)(nil).Write), arg0)
+}
+
+// MockReadWriteCloser is a mock of ReadWriteCloser interface.
+type MockReadWriteCloser struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadWriteCloserMockRecorder
+}
+
+// MockReadWriteCloserMockRecorder is the mock recorder for MockReadWriteCloser.
+type MockReadWriteCloserMockRecorder struct {
+	mock *MockReadWriteCloser
+	stub bool
+}
+
+// NewMockReadWriteCloser creates a new mock instance.
+func NewMockReadWriteCloser(ctrl *gomock.Controller) *MockReadWriteCloser {
+	mock := &MockReadWriteCloser{ctrl: ctrl}
+	mock.recorder = &MockReadWriteCloserMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadWriteCloser) EXPECT() *MockReadWriteCloserMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadWriteCloser) STUB() *MockReadWriteCloserMockRecorder {
+	return &MockReadWriteCloserMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockReadWriteCloser) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockReadWriteCloserMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadWriteCloser)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadWriteCloser)(nil).Close))
+}
+
+// Read mocks base method.
+func (m *MockReadWriteCloser) Read(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReadWriteCloserMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadWriteCloser)(nil).Read), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadWriteCloser)(nil).Read), arg0)
+}
+
+// Write mocks base method.
+func (m *MockReadWriteCloser) Write(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockReadWriteCloserMockRecorder) Write(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockReadWriteCloser)(nil).Write), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockReadWriteCloser)(nil).Write), arg0)
+}
+
+// MockReadSeeker is a mock of ReadSeeker interface.
+type MockReadSeeker struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadSeekerMockRecorder
+}
+
+// MockReadSeekerMockRecorder is the mock recorder for MockReadSeeker.
+type MockReadSeekerMockRecorder struct {
+	mock *MockReadSeeker
+	stub bool
+}
+
+// NewMockReadSeeker creates a new mock instance.
+func NewMockReadSeeker(ctrl *gomock.Controller) *MockReadSeeker {
+	mock := &MockReadSeeker{ctrl: ctrl}
+	mock.recorder = &MockReadSeekerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadSeeker) EXPECT() *MockReadSeekerMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadSeeker) STUB() *MockReadSeekerMockRecorder {
+	return &MockReadSeekerMockRecorder{mock: m, stub: true}
+}
+
+// Read mocks base method.
+func (m *MockReadSeeker) Read(arg0 []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReadSeekerMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadSeeker)(nil).Read), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadSeeker)(nil).Read), arg0)
+}
+
+// Seek mocks base method.
+func (m *MockReadSeeker) Seek(arg0 int64, arg1 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seek", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seek indicates an expected call of Seek.
+func (mr *MockReadSeekerMockRecorder) Seek(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockReadSeeker)(nil).Seek), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockReadSeeker)(nil).Seek), arg0, arg1)
+}
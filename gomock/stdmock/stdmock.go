@@ -0,0 +1,34 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stdmock holds mocks of commonly used interfaces of the standard
+// library, generated by mockgen. Each standard library package has its mocks
+// in a package named like those generated by mockgen by default:
+//
+//	mock_io      io
+//	mock_net     net
+//	mock_http    net/http
+//	mock_driver  database/sql/driver
+//	mock_fs      io/fs (Go 1.16 and later)
+//
+// The mocks are regenerated with go generate, and TestGenerated checks that
+// they are up to date with mockgen and the Go version in use when
+// GOMOCK_CHECK_GENERATED is set.
+package stdmock
+
+//go:generate mockgen -destination mock_io/mock_io.go io Reader,Writer,Closer,Seeker,ReaderAt,WriterAt,StringWriter,ReadCloser,WriteCloser,ReadWriter,ReadWriteCloser,ReadSeeker
+//go:generate mockgen -destination mock_net/mock_net.go net Conn,PacketConn,Listener,Addr,Error
+//go:generate mockgen -destination mock_http/mock_http.go net/http RoundTripper,Handler,ResponseWriter,Flusher,Hijacker,CookieJar
+//go:generate mockgen -destination mock_driver/mock_driver.go database/sql/driver Driver,Connector,Conn,Stmt,Tx,Rows,Result,Pinger,ConnBeginTx,ConnPrepareContext,ExecerContext,QueryerContext
+//go:generate mockgen -destination mock_fs/mock_fs.go -build_constraint go1.16 io/fs FS,File,DirEntry,FileInfo,ReadDirFile,ReadDirFS,ReadFileFS,StatFS,GlobFS,SubFS
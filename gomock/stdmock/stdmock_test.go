@@ -0,0 +1,105 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdmock_test
+
+import (
+	"bytes"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestGenerated runs the go:generate directives of the package into a
+// temporary directory, and checks that the output matches the checked in
+// mocks. It fails when mockgen or the Go version in use generate different
+// mocks, in which case they should be regenerated with go generate.
+//
+// Since a new Go release may add methods to the mocked interfaces, it only
+// runs when GOMOCK_CHECK_GENERATED is set, as it is in CI for the Go version
+// the mocks are generated with.
+func TestGenerated(t *testing.T) {
+	if os.Getenv("GOMOCK_CHECK_GENERATED") == "" {
+		t.Skip("skipping mock generation; set GOMOCK_CHECK_GENERATED to run it")
+	}
+
+	tmp := t.TempDir()
+	mockgen := filepath.Join(tmp, "mockgen")
+	if runtime.GOOS == "windows" {
+		mockgen += ".exe"
+	}
+	if out, err := exec.Command("go", "build", "-o", mockgen, "github.com/golang/mock/mockgen").CombinedOutput(); err != nil {
+		t.Fatalf("building mockgen: %v\n%s", err, out)
+	}
+
+	src, err := ioutil.ReadFile("stdmock.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(src), "\n") {
+		if !strings.HasPrefix(line, "//go:generate mockgen ") {
+			continue
+		}
+		args := strings.Fields(strings.TrimPrefix(line, "//go:generate mockgen "))
+		var want, constraint string
+		for i := 0; i < len(args)-1; i++ {
+			switch args[i] {
+			case "-destination":
+				want = args[i+1]
+				args[i+1] = filepath.Join(tmp, filepath.FromSlash(want))
+			case "-build_constraint":
+				constraint = args[i+1]
+			}
+		}
+		if want == "" {
+			t.Fatalf("directive without -destination: %s", line)
+		}
+
+		t.Run(filepath.Dir(want), func(t *testing.T) {
+			if constraint != "" && !hasReleaseTag(constraint) {
+				t.Skipf("mocks require %s", constraint)
+			}
+			if out, err := exec.Command(mockgen, args...).CombinedOutput(); err != nil {
+				t.Fatalf("mockgen %s: %v\n%s", strings.Join(args, " "), err, out)
+			}
+			got, err := ioutil.ReadFile(filepath.Join(tmp, filepath.FromSlash(want)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkedIn, err := ioutil.ReadFile(filepath.FromSlash(want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, checkedIn) {
+				t.Errorf("%s is out of date with %s; run go generate in gomock/stdmock", want, runtime.Version())
+			}
+		})
+	}
+}
+
+// hasReleaseTag reports whether the Go version in use satisfies tag, such as
+// go1.16.
+func hasReleaseTag(tag string) bool {
+	for _, t := range build.Default.ReleaseTags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,378 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// replay is the content of a reader read by a stream matcher.
+type replay struct {
+	data []byte
+	err  error // the error that ended the read, other than io.EOF
+}
+
+// streams holds the readers passed to the calls in progress, with their
+// content once a stream matcher has read it, so that every matcher of a call
+// sees the same content, and its actions can be passed it. A reader is only
+// held while a call it is passed to is in progress, by Controller.Call, and
+// calls in progress with the same reader share its content.
+//
+// Readers of types that aren't comparable, which are rare since readers are
+// usually pointers, can't be held: they are read by each matcher, and the
+// actions of the call are passed them as they are left.
+var streams = struct {
+	sync.Mutex
+	m map[io.Reader]*stream
+}{m: make(map[io.Reader]*stream)}
+
+// A stream is a reader passed to calls in progress.
+type stream struct {
+	calls int       // the number of calls in progress it is passed to
+	once  sync.Once // reads the reader
+	rp    *replay   // its content; nil until it is read
+}
+
+// read reads r, the reader of st, unless it has already been read.
+func (st *stream) read(r io.Reader) *replay {
+	st.once.Do(func() {
+		rp := readAll(r)
+		streams.Lock()
+		st.rp = rp
+		streams.Unlock()
+	})
+	return st.rp
+}
+
+// A streamScope lists the readers held for a call in progress.
+type streamScope []heldStream
+
+// A heldStream is a reader passed to a call in progress as the argument at
+// index arg.
+type heldStream struct {
+	arg int
+	r   io.Reader
+	st  *stream
+}
+
+// openStreams holds the readers in args until the returned scope is closed,
+// and reads those compared to stream matchers by an expected call or stub of
+// the method.
+//
+// They are read before the controller's lock is taken, since reading a reader
+// may block on another goroutine that calls the mocks of the controller, as
+// the writer of a pipe might.
+func (ctrl *Controller) openStreams(receiver interface{}, method string, args []interface{}) streamScope {
+	s := holdStreams(args)
+	if len(s) == 0 {
+		return nil
+	}
+
+	ctrl.mu.Lock()
+	read := ctrl.expectedCalls.streamArgs(callSetKey{receiver, method}, len(args))
+	ctrl.mu.Unlock()
+
+	for _, h := range s {
+		if read[h.arg] {
+			h.st.read(h.r)
+		}
+	}
+	return s
+}
+
+// holdStreams holds the readers in args until the returned scope is closed.
+func holdStreams(args []interface{}) streamScope {
+	var s streamScope
+	for i, arg := range args {
+		if r, ok := streamKey(arg); ok {
+			s = append(s, heldStream{arg: i, r: r})
+		}
+	}
+	if len(s) == 0 {
+		return nil
+	}
+
+	streams.Lock()
+	defer streams.Unlock()
+	for i, h := range s {
+		st := streams.m[h.r]
+		if st == nil {
+			st = &stream{}
+			streams.m[h.r] = st
+		}
+		st.calls++
+		s[i].st = st
+	}
+	return s
+}
+
+// close forgets the readers of s that no other call in progress holds.
+func (s streamScope) close() {
+	if len(s) == 0 {
+		return
+	}
+	streams.Lock()
+	defer streams.Unlock()
+	for _, h := range s {
+		if h.st.calls--; h.st.calls == 0 {
+			delete(streams.m, h.r)
+		}
+	}
+}
+
+// streamKey returns x as a key of streams, if it is a non-nil reader of a
+// comparable type.
+func streamKey(x interface{}) (io.Reader, bool) {
+	r, ok := x.(io.Reader)
+	if !ok {
+		return nil, false
+	}
+	if v := reflect.ValueOf(r); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, false
+	}
+	return r, reflect.TypeOf(r).Comparable()
+}
+
+// isStreamMatcher reports whether m reads the readers it is matched against,
+// alone or combined with Not or All.
+func isStreamMatcher(m Matcher) bool {
+	switch m := m.(type) {
+	case readerMatcher:
+		return true
+	case notMatcher:
+		return isStreamMatcher(m.m)
+	case allMatcher:
+		for _, m := range m.matchers {
+			if isStreamMatcher(m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readStream returns the content of x if it is a reader, reading it only once
+// per call in progress. The readers of a call in progress that are compared to
+// stream matchers have already been read by Controller.Call.
+func readStream(x interface{}) (*replay, bool) {
+	r, ok := x.(io.Reader)
+	if !ok {
+		return nil, false
+	}
+	if v := reflect.ValueOf(r); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, false
+	}
+
+	if _, ok := streamKey(r); ok {
+		streams.Lock()
+		st := streams.m[r]
+		streams.Unlock()
+		if st != nil {
+			return st.read(r), true
+		}
+	}
+	return readAll(r), true
+}
+
+// readAll reads r to its end. Readers that can be are rewound, so that the
+// caller can read them again.
+func readAll(r io.Reader) *replay {
+	rp := &replay{}
+	switch r := r.(type) {
+	case *bytes.Buffer:
+		rp.data = append([]byte(nil), r.Bytes()...)
+	case io.ReadSeeker:
+		off, err := r.Seek(0, io.SeekCurrent)
+		rp.data, rp.err = ioutil.ReadAll(r)
+		if err == nil {
+			r.Seek(off, io.SeekStart)
+		}
+	default:
+		rp.data, rp.err = ioutil.ReadAll(r)
+	}
+	return rp
+}
+
+// replayStreams replaces the readers in args read by stream matchers with
+// readers of the same content, where the parameters of methodType allow it.
+// The readers must be held by a scope of openStreams.
+func replayStreams(methodType reflect.Type, args []interface{}) []interface{} {
+	var replaced []interface{}
+	for i, arg := range args {
+		r, ok := streamKey(arg)
+		if !ok {
+			continue
+		}
+		streams.Lock()
+		var rp *replay
+		if st := streams.m[r]; st != nil {
+			rp = st.rp
+		}
+		streams.Unlock()
+		if rp == nil {
+			continue
+		}
+		var nr io.Reader = bytes.NewReader(rp.data)
+		if rp.err != nil {
+			nr = io.MultiReader(nr, errReader{rp.err})
+		}
+		if t := paramType(methodType, i); t == nil || !reflect.TypeOf(nr).AssignableTo(t) {
+			continue
+		}
+		if replaced == nil {
+			replaced = append([]interface{}(nil), args...)
+		}
+		replaced[i] = nr
+	}
+	if replaced == nil {
+		return args
+	}
+	return replaced
+}
+
+// paramType returns the type of the i-th argument of a call to a method of
+// type methodType, or nil if there is none.
+func paramType(methodType reflect.Type, i int) reflect.Type {
+	if methodType == nil {
+		return nil
+	}
+	n := methodType.NumIn()
+	if methodType.IsVariadic() && i >= n-1 {
+		return methodType.In(n - 1).Elem()
+	}
+	if i >= n {
+		return nil
+	}
+	return methodType.In(i)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+type readerMatcher struct {
+	desc  string
+	match func(data []byte) bool
+}
+
+func (m readerMatcher) Matches(x interface{}) bool {
+	rp, ok := readStream(x)
+	return ok && rp.err == nil && m.match(rp.data)
+}
+
+func (m readerMatcher) String() string {
+	return m.desc
+}
+
+// Got implements GotFormatter, printing the content of the reader instead of
+// the reader itself.
+func (m readerMatcher) Got(got interface{}) string {
+	rp, ok := readStream(got)
+	if !ok {
+		return fmt.Sprintf("%v (%T)", got, got)
+	}
+	content := truncate(fmt.Sprintf("%q", rp.data))
+	if rp.err != nil {
+		return fmt.Sprintf("reader of %s (%T) failing with %v", content, got, rp.err)
+	}
+	return fmt.Sprintf("reader of %s (%T)", content, got)
+}
+
+// ReaderEq returns a matcher for readers whose content is equal to content.
+//
+// Like the other reader matchers, it reads the received io.Reader to its end.
+// The actions of the call are passed a new reader of the same content instead,
+// when the parameter type allows it. Readers that implement io.Seeker and
+// *bytes.Buffer are also left unread for the caller.
+//
+// Example usage:
+//
+//	ReaderEq([]byte("hello")).Matches(strings.NewReader("hello")) // returns true
+func ReaderEq(content []byte) Matcher {
+	return readerMatcher{
+		desc:  fmt.Sprintf("is a reader of %q", content),
+		match: func(data []byte) bool { return bytes.Equal(data, content) },
+	}
+}
+
+// ReaderHasPrefix returns a matcher for readers whose content starts with
+// prefix.
+func ReaderHasPrefix(prefix []byte) Matcher {
+	return readerMatcher{
+		desc:  fmt.Sprintf("is a reader of content starting with %q", prefix),
+		match: func(data []byte) bool { return bytes.HasPrefix(data, prefix) },
+	}
+}
+
+// ReaderMatchesRegexp returns a matcher for readers whose content matches the
+// regular expression expr. It panics if expr cannot be parsed.
+func ReaderMatchesRegexp(expr string) Matcher {
+	re := regexp.MustCompile(expr)
+	return readerMatcher{
+		desc:  fmt.Sprintf("is a reader of content matching %q", expr),
+		match: re.Match,
+	}
+}
+
+// ReaderJSON returns a matcher for readers of a JSON value, decoded into an
+// interface{}, for which pred returns true.
+//
+// Example usage:
+//
+//	ReaderJSON(func(v interface{}) bool {
+//	  return v.(map[string]interface{})["name"] == "gopher"
+//	})
+func ReaderJSON(pred func(v interface{}) bool) Matcher {
+	return readerMatcher{
+		desc: "is a reader of JSON satisfying the predicate",
+		match: func(data []byte) bool {
+			var v interface{}
+			if err := json.Unmarshal(data, &v); err != nil {
+				return false
+			}
+			return pred(v)
+		},
+	}
+}
+
+// ReaderLines returns a matcher for readers whose lines, without their line
+// endings, satisfy pred.
+func ReaderLines(pred func(lines []string) bool) Matcher {
+	return readerMatcher{
+		desc: "is a reader of lines satisfying the predicate",
+		match: func(data []byte) bool {
+			var lines []string
+			if s := strings.TrimSuffix(string(data), "\n"); len(data) > 0 {
+				lines = strings.Split(s, "\n")
+				for i, line := range lines {
+					lines[i] = strings.TrimSuffix(line, "\r")
+				}
+			}
+			return pred(lines)
+		},
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// This file contains the generation of the mocks listed in a config file.
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/mock/mockgen/model"
+
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/yaml.v2"
+)
+
+var configFile = flag.String("config", "", "(config mode) YAML or JSON file listing the mocks to generate; enables config mode.")
+
+// A config lists the mocks to generate in config mode.
+type config struct {
+	Jobs []*configJob `yaml:"jobs" json:"jobs"`
+}
+
+// A configJob generates a mock file, like mockgen does in source mode, or in
+// package mode for an import path. The fields other than ImportPath are named
+// after the flags they correspond to. The interfaces of a source file may be
+// glob patterns, like those of the -interfaces flag.
+type configJob struct {
+	Source            string            `yaml:"source" json:"source"`
+	ImportPath        string            `yaml:"import_path" json:"import_path"`
+	Interfaces        []string          `yaml:"interfaces" json:"interfaces"`
+	ExcludeInterfaces []string          `yaml:"exclude_interfaces" json:"exclude_interfaces"`
+	Destination       string            `yaml:"destination" json:"destination"`
+	Package           string            `yaml:"package" json:"package"`
+	MockNames         map[string]string `yaml:"mock_names" json:"mock_names"`
+	SelfPackage       string            `yaml:"self_package" json:"self_package"`
+	CopyrightFile     string            `yaml:"copyright_file" json:"copyright_file"`
+	BuildFlags        string            `yaml:"build_flags" json:"build_flags"`
+}
+
+// readConfig reads a config file. Files with the .json extension are decoded
+// as JSON, and other files as YAML.
+func readConfig(path string) (*config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &config{}
+	if filepath.Ext(path) == ".json" {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(cfg)
+	} else {
+		err = yaml.UnmarshalStrict(data, cfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %v: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// validate checks that job is complete and consistent.
+func (job *configJob) validate() error {
+	switch {
+	case job.Source == "" && job.ImportPath == "":
+		return errors.New("one of source and import_path is required")
+	case job.Source != "" && job.ImportPath != "":
+		return errors.New("source and import_path are mutually exclusive")
+	case job.ImportPath != "" && len(job.ExcludeInterfaces) > 0:
+		return errors.New("exclude_interfaces can only be given with source")
+	case job.ImportPath != "" && len(job.Interfaces) == 0:
+		return errors.New("import_path requires interfaces")
+	case job.Destination == "":
+		return errors.New("destination is required")
+	}
+	return nil
+}
+
+// configMode generates the mocks listed in the config file at path. The
+// paths of the files named in the config file are relative to its directory.
+// A failing job is reported, and doesn't stop the other jobs.
+func configMode(path string) error {
+	cfg, err := readConfig(path)
+	if err != nil {
+		return err
+	}
+
+	r := &configRunner{
+		dir:      filepath.Dir(path),
+		parsers:  make(map[string]*fileParser),
+		packages: make(map[string]*packageSet),
+	}
+	r.loadPackages(cfg.Jobs)
+
+	failed := 0
+	for i, job := range cfg.Jobs {
+		if err := r.run(job); err != nil {
+			log.Printf("Job %d (%v) failed: %v", i+1, job.Destination, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d jobs failed", failed, len(cfg.Jobs))
+	}
+	return nil
+}
+
+// A configRunner runs the jobs of a config file. The jobs that read the same
+// source directory share a parser, and thus the interfaces it has parsed.
+type configRunner struct {
+	dir      string
+	parsers  map[string]*fileParser // by source directory
+	packages map[string]*packageSet // by build flags
+}
+
+// A packageSet holds the packages of the jobs with the same build flags,
+// which are loaded and type checked together.
+type packageSet struct {
+	checker *packageChecker
+	pkgs    map[string]*packages.Package // by import path
+	err     error
+}
+
+// loadPackages loads the packages of the jobs with an import path.
+func (r *configRunner) loadPackages(jobs []*configJob) {
+	importPaths := make(map[string][]string) // by build flags
+	for _, job := range jobs {
+		if job.ImportPath != "" {
+			flags := r.buildFlags(job)
+			importPaths[flags] = append(importPaths[flags], job.ImportPath)
+		}
+	}
+
+	for flags, paths := range importPaths {
+		fset := token.NewFileSet()
+		set := &packageSet{
+			checker: newPackageChecker(fset),
+			pkgs:    make(map[string]*packages.Package),
+		}
+		r.packages[flags] = set
+		pkgs, err := loadPackages(fset, flags, paths...)
+		if err != nil {
+			set.err = fmt.Errorf("loading packages %v: %v", strings.Join(paths, " "), err)
+			continue
+		}
+		for _, pkg := range pkgs {
+			set.pkgs[pkg.PkgPath] = pkg
+		}
+	}
+}
+
+func (r *configRunner) buildFlags(job *configJob) string {
+	if job.BuildFlags != "" {
+		return job.BuildFlags
+	}
+	return *buildFlags
+}
+
+// path returns the path of a file named in the config file.
+func (r *configRunner) path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(r.dir, name)
+}
+
+func (r *configRunner) run(job *configJob) error {
+	if err := job.validate(); err != nil {
+		return err
+	}
+
+	g := newGenerator()
+	var pkg *model.Package
+	var err error
+	if job.Source != "" {
+		pkg, err = r.parseSource(job)
+		g.filename = job.Source
+		if err == nil && !isSourceFile(job.Source) {
+			g.filename = pkg.PkgPath
+		}
+	} else {
+		pkg, err = r.parsePackage(job)
+		g.srcPackage = job.ImportPath
+		g.srcInterfaces = strings.Join(job.Interfaces, ",")
+	}
+	if err != nil {
+		return fmt.Errorf("loading input failed: %v", err)
+	}
+
+	if job.CopyrightFile != "" {
+		header, err := ioutil.ReadFile(r.path(job.CopyrightFile))
+		if err != nil {
+			return fmt.Errorf("failed reading copyright file: %v", err)
+		}
+		g.copyrightHeader = string(header)
+	}
+
+	outputPackageName := job.Package
+	if outputPackageName == "" {
+		outputPackageName = "mock_" + sanitize(pkg.Name)
+	}
+	dst := r.path(job.Destination)
+	outputPackagePath := job.SelfPackage
+	if outputPackagePath == "" {
+		outputPackagePath = inferOutputPackagePath(dst)
+	}
+
+	g.destination = dst
+	g.mockNames = job.MockNames
+	if err := g.Generate(pkg, outputPackageName, outputPackagePath); err != nil {
+		return fmt.Errorf("failed generating mock: %v", err)
+	}
+	return writeOutput(dst, g.Output())
+}
+
+// parseSource parses the source of a job, which is a Go file, a directory or
+// an import path, like the -source flag.
+func (r *configRunner) parseSource(job *configJob) (*model.Package, error) {
+	filter, err := newTypeFilter(job.Interfaces, job.ExcludeInterfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	source := r.path(job.Source)
+	if isSourceFile(source) {
+		srcDir, err := filepath.Abs(filepath.Dir(source))
+		if err != nil {
+			return nil, fmt.Errorf("failed getting source directory: %v", err)
+		}
+		return r.parser(srcDir).parseSource(source, "", "", filter)
+	}
+
+	if fi, err := os.Stat(source); err != nil || !fi.IsDir() {
+		source = job.Source // an import path
+	}
+	bpkg, err := importSourcePackage(source)
+	if err != nil {
+		return nil, err
+	}
+	return r.parser(bpkg.Dir).parseSourcePackage(bpkg, "", "", filter)
+}
+
+// parser returns the parser shared by the jobs that read srcDir.
+func (r *configRunner) parser(srcDir string) *fileParser {
+	p, ok := r.parsers[srcDir]
+	if !ok {
+		p = newFileParser(srcDir)
+		r.parsers[srcDir] = p
+	}
+	return p
+}
+
+func (r *configRunner) parsePackage(job *configJob) (*model.Package, error) {
+	set := r.packages[r.buildFlags(job)]
+	if set.err != nil {
+		return nil, set.err
+	}
+	pkg, ok := set.pkgs[job.ImportPath]
+	if !ok {
+		return nil, fmt.Errorf("package %v not found", job.ImportPath)
+	}
+	if len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("loading package %v: %v", job.ImportPath, pkg.Errors[0])
+	}
+
+	tpkg, err := set.checker.check(pkg)
+	if err != nil {
+		return nil, fmt.Errorf("type checking package %v: %v", job.ImportPath, err)
+	}
+	return (&packageModeParser{}).parsePackage(tpkg, job.Interfaces)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadConfig(t *testing.T) {
+	want := &config{
+		Jobs: []*configJob{
+			{
+				Source:      "foo.go",
+				Destination: "mock/foo.go",
+			},
+			{
+				ImportPath:  "io",
+				Interfaces:  []string{"Reader", "Writer"},
+				Destination: "mock/io.go",
+				Package:     "mock",
+				MockNames:   map[string]string{"Reader": "MockIOReader"},
+				BuildFlags:  "-tags=foo",
+			},
+		},
+	}
+	files := map[string]string{
+		"mockgen.yaml": `
+jobs:
+  - source: foo.go
+    destination: mock/foo.go
+  - import_path: io
+    interfaces: [Reader, Writer]
+    destination: mock/io.go
+    package: mock
+    mock_names: {Reader: MockIOReader}
+    build_flags: -tags=foo
+`,
+		"mockgen.json": `{
+	"jobs": [
+		{"source": "foo.go", "destination": "mock/foo.go"},
+		{
+			"import_path": "io",
+			"interfaces": ["Reader", "Writer"],
+			"destination": "mock/io.go",
+			"package": "mock",
+			"mock_names": {"Reader": "MockIOReader"},
+			"build_flags": "-tags=foo"
+		}
+	]
+}`,
+		"unknown.yaml": "jobs:\n  - sources: foo.go\n",
+		"unknown.json": `{"jobs": [{"sources": "foo.go"}]}`,
+	}
+
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"mockgen.yaml", "mockgen.json"} {
+		got, err := readConfig(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("readConfig(%v): %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("readConfig(%v): got %+v, want %+v", name, got, want)
+		}
+	}
+	for _, name := range []string{"unknown.yaml", "unknown.json"} {
+		if _, err := readConfig(filepath.Join(dir, name)); err == nil {
+			t.Errorf("readConfig(%v): expected an error for the unknown field", name)
+		}
+	}
+}
+
+func TestConfigJob_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		job     configJob
+		wantErr bool
+	}{
+		{"source", configJob{Source: "foo.go", Destination: "mock.go"}, false},
+		{"import path", configJob{ImportPath: "io", Interfaces: []string{"Reader"}, Destination: "mock.go"}, false},
+		{"no input", configJob{Destination: "mock.go"}, true},
+		{"both inputs", configJob{Source: "foo.go", ImportPath: "io", Interfaces: []string{"Reader"}, Destination: "mock.go"}, true},
+		{"source with interfaces", configJob{Source: "foo.go", Interfaces: []string{"Foo*"}, ExcludeInterfaces: []string{"FooBar"}, Destination: "mock.go"}, false},
+		{"import path with excluded interfaces", configJob{ImportPath: "io", Interfaces: []string{"Reader"}, ExcludeInterfaces: []string{"Writer"}, Destination: "mock.go"}, true},
+		{"no interfaces", configJob{ImportPath: "io", Destination: "mock.go"}, true},
+		{"no destination", configJob{Source: "foo.go"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.job.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate: got %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigMode_FailingJob(t *testing.T) {
+	source, err := filepath.Abs("internal/tests/config_mode/reader.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := `
+jobs:
+  - source: missing.go
+    destination: mock_missing.go
+  - source: ` + source + `
+    destination: mock_reader.go
+`
+	path := filepath.Join(dir, "mockgen.yaml")
+	if err := ioutil.WriteFile(path, []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := configMode(path); err == nil || err.Error() != "1 of 2 jobs failed" {
+		t.Errorf("configMode: got %v, want 1 of 2 jobs failed", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "mock_reader.go")); err != nil {
+		t.Errorf("the job after the failing one didn't run: %v", err)
+	}
+}
@@ -20,12 +20,13 @@ type MockSource struct {
 // MockSourceMockRecorder is the mock recorder for MockSource.
 type MockSourceMockRecorder struct {
 	mock *MockSource
+	stub bool
 }
 
 // NewMockSource creates a new mock instance.
 func NewMockSource(ctrl *gomock.Controller) *MockSource {
 	mock := &MockSource{ctrl: ctrl}
-	mock.recorder = &MockSourceMockRecorder{mock}
+	mock.recorder = &MockSourceMockRecorder{mock: mock}
 	return mock
 }
 
@@ -34,6 +35,12 @@ func (m *MockSource) EXPECT() *MockSourceMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockSource) STUB() *MockSourceMockRecorder {
+	return &MockSourceMockRecorder{mock: m, stub: true}
+}
+
 // Error mocks base method.
 func (m *MockSource) Error() string {
 	m.ctrl.T.Helper()
@@ -45,6 +52,9 @@ func (m *MockSource) Error() string {
 // Error indicates an expected call of Error.
 func (mr *MockSourceMockRecorder) Error() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSource)(nil).Error))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSource)(nil).Error))
 }
 
@@ -59,5 +69,8 @@ func (m *MockSource) Method() faux.Return {
 // Method indicates an expected call of Method.
 func (mr *MockSourceMockRecorder) Method() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Method", reflect.TypeOf((*MockSource)(nil).Method))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Method", reflect.TypeOf((*MockSource)(nil).Method))
 }
@@ -0,0 +1,47 @@
+package config_mode_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/mock/mockgen/internal/tests/config_mode"
+	"github.com/golang/mock/mockgen/internal/tests/config_mode/fake"
+	"github.com/golang/mock/mockgen/internal/tests/config_mode/mock_config_mode"
+)
+
+var (
+	_ config_mode.Reader = &mock_config_mode.MockReader{}
+	_ config_mode.Store  = &mock_config_mode.MockStore{}
+	_ config_mode.Store  = &fake.Store{}
+	_ io.Reader          = &mock_config_mode.MockIOReader{}
+	_ io.Writer          = &mock_config_mode.MockIOWriter{}
+)
+
+func TestMockStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errNotFound := errors.New("not found")
+
+	s := mock_config_mode.NewMockStore(ctrl)
+	s.EXPECT().Write("k", []byte("v")).Return(nil)
+	s.EXPECT().Read("x").Return(nil, errNotFound)
+
+	if err := s.Write("k", []byte("v")); err != nil {
+		t.Errorf("Write: got %v, want nil", err)
+	}
+	if _, err := s.Read("x"); err != errNotFound {
+		t.Errorf("Read: got %v, want %v", err, errNotFound)
+	}
+}
+
+func TestFakeStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	s := fake.NewStore(ctrl)
+	s.EXPECT().Delete("k").Return(nil)
+
+	if err := s.Delete("k"); err != nil {
+		t.Errorf("Delete: got %v, want nil", err)
+	}
+}
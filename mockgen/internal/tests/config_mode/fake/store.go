@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/golang/mock/mockgen/internal/tests/config_mode (interfaces: Store)
+
+// Package fake is a generated GoMock package.
+package fake
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Store is a mock of Store interface.
+type Store struct {
+	ctrl     *gomock.Controller
+	recorder *StoreMockRecorder
+}
+
+// StoreMockRecorder is the mock recorder for Store.
+type StoreMockRecorder struct {
+	mock *Store
+	stub bool
+}
+
+// NewStore creates a new mock instance.
+func NewStore(ctrl *gomock.Controller) *Store {
+	mock := &Store{ctrl: ctrl}
+	mock.recorder = &StoreMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Store) EXPECT() *StoreMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *Store) STUB() *StoreMockRecorder {
+	return &StoreMockRecorder{mock: m, stub: true}
+}
+
+// Delete mocks base method.
+func (m *Store) Delete(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *StoreMockRecorder) Delete(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Store)(nil).Delete), key)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Store)(nil).Delete), key)
+}
+
+// Read mocks base method.
+func (m *Store) Read(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *StoreMockRecorder) Read(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*Store)(nil).Read), key)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*Store)(nil).Read), key)
+}
+
+// Write mocks base method.
+func (m *Store) Write(key string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *StoreMockRecorder) Write(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*Store)(nil).Write), key, value)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*Store)(nil).Write), key, value)
+}
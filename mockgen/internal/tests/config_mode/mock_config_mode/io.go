@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: io (interfaces: Reader,Writer)
+
+// Package mock_config_mode is a generated GoMock package.
+package mock_config_mode
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIOReader is a mock of Reader interface.
+type MockIOReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOReaderMockRecorder
+}
+
+// MockIOReaderMockRecorder is the mock recorder for MockIOReader.
+type MockIOReaderMockRecorder struct {
+	mock *MockIOReader
+	stub bool
+}
+
+// NewMockIOReader creates a new mock instance.
+func NewMockIOReader(ctrl *gomock.Controller) *MockIOReader {
+	mock := &MockIOReader{ctrl: ctrl}
+	mock.recorder = &MockIOReaderMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOReader) EXPECT() *MockIOReaderMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockIOReader) STUB() *MockIOReaderMockRecorder {
+	return &MockIOReaderMockRecorder{mock: m, stub: true}
+}
+
+// Read mocks base method.
+func (m *MockIOReader) Read(p []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", p)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockIOReaderMockRecorder) Read(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockIOReader)(nil).Read), p)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockIOReader)(nil).Read), p)
+}
+
+// MockIOWriter is a mock of Writer interface.
+type MockIOWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOWriterMockRecorder
+}
+
+// MockIOWriterMockRecorder is the mock recorder for MockIOWriter.
+type MockIOWriterMockRecorder struct {
+	mock *MockIOWriter
+	stub bool
+}
+
+// NewMockIOWriter creates a new mock instance.
+func NewMockIOWriter(ctrl *gomock.Controller) *MockIOWriter {
+	mock := &MockIOWriter{ctrl: ctrl}
+	mock.recorder = &MockIOWriterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOWriter) EXPECT() *MockIOWriterMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockIOWriter) STUB() *MockIOWriterMockRecorder {
+	return &MockIOWriterMockRecorder{mock: m, stub: true}
+}
+
+// Write mocks base method.
+func (m *MockIOWriter) Write(p []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", p)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockIOWriterMockRecorder) Write(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockIOWriter)(nil).Write), p)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockIOWriter)(nil).Write), p)
+}
@@ -0,0 +1,8 @@
+package config_mode
+
+//go:generate mockgen -config mockgen.yaml
+
+// Reader reads values by key.
+type Reader interface {
+	Read(key string) ([]byte, error)
+}
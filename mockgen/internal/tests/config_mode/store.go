@@ -0,0 +1,8 @@
+package config_mode
+
+// Store embeds Reader, which is declared in another file of the package.
+type Store interface {
+	Reader
+	Write(key string, value []byte) error
+	Delete(key string) error
+}
@@ -19,12 +19,13 @@ type MockI struct {
 // MockIMockRecorder is the mock recorder for MockI.
 type MockIMockRecorder struct {
 	mock *MockI
+	stub bool
 }
 
 // NewMockI creates a new mock instance.
 func NewMockI(ctrl *gomock.Controller) *MockI {
 	mock := &MockI{ctrl: ctrl}
-	mock.recorder = &MockIMockRecorder{mock}
+	mock.recorder = &MockIMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockI) EXPECT() *MockIMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockI) STUB() *MockIMockRecorder {
+	return &MockIMockRecorder{mock: m, stub: true}
+}
+
 // Bar mocks base method.
 func (m *MockI) Bar() [2]int {
 	m.ctrl.T.Helper()
@@ -44,6 +51,9 @@ func (m *MockI) Bar() [2]int {
 // Bar indicates an expected call of Bar.
 func (mr *MockIMockRecorder) Bar() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockI)(nil).Bar))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockI)(nil).Bar))
 }
 
@@ -58,6 +68,9 @@ func (m *MockI) Baz() [127]int {
 // Baz indicates an expected call of Baz.
 func (mr *MockIMockRecorder) Baz() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Baz", reflect.TypeOf((*MockI)(nil).Baz))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Baz", reflect.TypeOf((*MockI)(nil).Baz))
 }
 
@@ -72,6 +85,9 @@ func (m *MockI) Corge() [7]int {
 // Corge indicates an expected call of Corge.
 func (mr *MockIMockRecorder) Corge() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Corge", reflect.TypeOf((*MockI)(nil).Corge))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Corge", reflect.TypeOf((*MockI)(nil).Corge))
 }
 
@@ -86,6 +102,9 @@ func (m *MockI) Foo() [2]int {
 // Foo indicates an expected call of Foo.
 func (mr *MockIMockRecorder) Foo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Foo", reflect.TypeOf((*MockI)(nil).Foo))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Foo", reflect.TypeOf((*MockI)(nil).Foo))
 }
 
@@ -100,6 +119,9 @@ func (m *MockI) Quux() [3]int {
 // Quux indicates an expected call of Quux.
 func (mr *MockIMockRecorder) Quux() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Quux", reflect.TypeOf((*MockI)(nil).Quux))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quux", reflect.TypeOf((*MockI)(nil).Quux))
 }
 
@@ -114,5 +136,8 @@ func (m *MockI) Qux() [3]int {
 // Qux indicates an expected call of Qux.
 func (mr *MockIMockRecorder) Qux() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Qux", reflect.TypeOf((*MockI)(nil).Qux))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Qux", reflect.TypeOf((*MockI)(nil).Qux))
 }
@@ -24,12 +24,13 @@ type MockEmpty struct {
 // MockEmptyMockRecorder is the mock recorder for MockEmpty.
 type MockEmptyMockRecorder struct {
 	mock *MockEmpty
+	stub bool
 }
 
 // NewMockEmpty creates a new mock instance.
 func NewMockEmpty(ctrl *gomock.Controller) *MockEmpty {
 	mock := &MockEmpty{ctrl: ctrl}
-	mock.recorder = &MockEmptyMockRecorder{mock}
+	mock.recorder = &MockEmptyMockRecorder{mock: mock}
 	return mock
 }
 
@@ -37,3 +38,9 @@ func NewMockEmpty(ctrl *gomock.Controller) *MockEmpty {
 func (m *MockEmpty) EXPECT() *MockEmptyMockRecorder {
 	return m.recorder
 }
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockEmpty) STUB() *MockEmptyMockRecorder {
+	return &MockEmptyMockRecorder{mock: m, stub: true}
+}
@@ -20,12 +20,13 @@ type MockInputMaker struct {
 // MockInputMakerMockRecorder is the mock recorder for MockInputMaker.
 type MockInputMakerMockRecorder struct {
 	mock *MockInputMaker
+	stub bool
 }
 
 // NewMockInputMaker creates a new mock instance.
 func NewMockInputMaker(ctrl *gomock.Controller) *MockInputMaker {
 	mock := &MockInputMaker{ctrl: ctrl}
-	mock.recorder = &MockInputMakerMockRecorder{mock}
+	mock.recorder = &MockInputMakerMockRecorder{mock: mock}
 	return mock
 }
 
@@ -34,6 +35,12 @@ func (m *MockInputMaker) EXPECT() *MockInputMakerMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockInputMaker) STUB() *MockInputMakerMockRecorder {
+	return &MockInputMakerMockRecorder{mock: m, stub: true}
+}
+
 // MakeInput mocks base method.
 func (m *MockInputMaker) MakeInput() client.GreetInput {
 	m.ctrl.T.Helper()
@@ -45,5 +52,8 @@ func (m *MockInputMaker) MakeInput() client.GreetInput {
 // MakeInput indicates an expected call of MakeInput.
 func (mr *MockInputMakerMockRecorder) MakeInput() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "MakeInput", reflect.TypeOf((*MockInputMaker)(nil).MakeInput))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeInput", reflect.TypeOf((*MockInputMaker)(nil).MakeInput))
 }
@@ -0,0 +1,19 @@
+package deep_stubs
+
+//go:generate mockgen -deep_stubs -destination mock/client_mock.go -source=client.go
+
+// Client is an API client with nested services.
+type Client interface {
+	Users() UserService
+	Close() error
+}
+
+// UserService looks up users.
+type UserService interface {
+	Get(id int) (string, error)
+}
+
+// UserName returns the name of the user with the given ID.
+func UserName(c Client, id int) (string, error) {
+	return c.Users().Get(id)
+}
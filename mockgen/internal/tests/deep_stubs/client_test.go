@@ -0,0 +1,25 @@
+package deep_stubs_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/mock/mockgen/internal/tests/deep_stubs"
+	mock_deep_stubs "github.com/golang/mock/mockgen/internal/tests/deep_stubs/mock"
+)
+
+func TestUserName(t *testing.T) {
+	ctrl := gomock.NewController(t, gomock.WithDeepStubs())
+
+	client := mock_deep_stubs.NewMockClient(ctrl)
+	users := client.Users().(*mock_deep_stubs.MockUserService)
+	users.EXPECT().Get(1).Return("gopher", nil)
+
+	name, err := deep_stubs.UserName(client, 1)
+	if err != nil {
+		t.Fatalf("UserName: %v", err)
+	}
+	if name != "gopher" {
+		t.Errorf("UserName: got %q, want %q", name, "gopher")
+	}
+}
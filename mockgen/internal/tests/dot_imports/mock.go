@@ -22,12 +22,13 @@ type MockWithDotImports struct {
 // MockWithDotImportsMockRecorder is the mock recorder for MockWithDotImports.
 type MockWithDotImportsMockRecorder struct {
 	mock *MockWithDotImports
+	stub bool
 }
 
 // NewMockWithDotImports creates a new mock instance.
 func NewMockWithDotImports(ctrl *gomock.Controller) *MockWithDotImports {
 	mock := &MockWithDotImports{ctrl: ctrl}
-	mock.recorder = &MockWithDotImportsMockRecorder{mock}
+	mock.recorder = &MockWithDotImportsMockRecorder{mock: mock}
 	return mock
 }
 
@@ -36,6 +37,12 @@ func (m *MockWithDotImports) EXPECT() *MockWithDotImportsMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockWithDotImports) STUB() *MockWithDotImportsMockRecorder {
+	return &MockWithDotImportsMockRecorder{mock: m, stub: true}
+}
+
 // Method1 mocks base method.
 func (m *MockWithDotImports) Method1() Request {
 	m.ctrl.T.Helper()
@@ -47,6 +54,9 @@ func (m *MockWithDotImports) Method1() Request {
 // Method1 indicates an expected call of Method1.
 func (mr *MockWithDotImportsMockRecorder) Method1() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Method1", reflect.TypeOf((*MockWithDotImports)(nil).Method1))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Method1", reflect.TypeOf((*MockWithDotImports)(nil).Method1))
 }
 
@@ -61,6 +71,9 @@ func (m *MockWithDotImports) Method2() *bytes.Buffer {
 // Method2 indicates an expected call of Method2.
 func (mr *MockWithDotImportsMockRecorder) Method2() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Method2", reflect.TypeOf((*MockWithDotImports)(nil).Method2))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Method2", reflect.TypeOf((*MockWithDotImports)(nil).Method2))
 }
 
@@ -75,5 +88,8 @@ func (m *MockWithDotImports) Method3() Context {
 // Method3 indicates an expected call of Method3.
 func (mr *MockWithDotImportsMockRecorder) Method3() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Method3", reflect.TypeOf((*MockWithDotImports)(nil).Method3))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Method3", reflect.TypeOf((*MockWithDotImports)(nil).Method3))
 }
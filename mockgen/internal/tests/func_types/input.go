@@ -0,0 +1,26 @@
+package func_types
+
+//go:generate mockgen -destination source/mock.go -package source -source=input.go -interfaces Handler,Logf
+//go:generate mockgen -destination reflect/mock.go -package reflect . Handler,Logf
+
+import "context"
+
+// Msg is a message passed to a Handler.
+type Msg struct {
+	Body string
+}
+
+// Handler handles a message.
+type Handler func(ctx context.Context, msg *Msg) error
+
+// Logf logs a formatted message.
+type Logf func(format string, args ...interface{})
+
+// Dispatch passes each message to h, and logs the messages it fails on.
+func Dispatch(ctx context.Context, h Handler, logf Logf, msgs ...*Msg) {
+	for _, msg := range msgs {
+		if err := h(ctx, msg); err != nil {
+			logf("handling %q: %v", msg.Body, err)
+		}
+	}
+}
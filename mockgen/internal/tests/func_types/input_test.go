@@ -0,0 +1,39 @@
+package func_types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/mock/mockgen/internal/tests/func_types"
+	"github.com/golang/mock/mockgen/internal/tests/func_types/reflect"
+	"github.com/golang/mock/mockgen/internal/tests/func_types/source"
+)
+
+func TestDispatchSourceMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	ok, bad := &func_types.Msg{Body: "ok"}, &func_types.Msg{Body: "bad"}
+
+	h := source.NewMockHandler(ctrl)
+	h.EXPECT().Call(ctx, ok).Return(nil)
+	h.EXPECT().Call(ctx, bad).Return(errors.New("boom"))
+	logf := source.NewMockLogf(ctrl)
+	logf.EXPECT().Call("handling %q: %v", "bad", gomock.Any())
+
+	func_types.Dispatch(ctx, h.Fn(), logf.Fn(), ok, bad)
+}
+
+func TestDispatchReflectMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	msg := &func_types.Msg{Body: "bad"}
+
+	h := reflect.NewMockHandler(ctrl)
+	h.EXPECT().Call(ctx, msg).Return(errors.New("boom"))
+	logf := reflect.NewMockLogf(ctrl)
+	logf.EXPECT().Call("handling %q: %v", "bad", gomock.Any())
+
+	func_types.Dispatch(ctx, h.Fn(), logf.Fn(), msg)
+}
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/golang/mock/mockgen/internal/tests/func_types (interfaces: Handler,Logf)
+
+// Package reflect is a generated GoMock package.
+package reflect
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	func_types "github.com/golang/mock/mockgen/internal/tests/func_types"
+)
+
+// MockHandler is a mock of Handler func type.
+type MockHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandlerMockRecorder
+}
+
+// MockHandlerMockRecorder is the mock recorder for MockHandler.
+type MockHandlerMockRecorder struct {
+	mock *MockHandler
+	stub bool
+}
+
+// NewMockHandler creates a new mock instance.
+func NewMockHandler(ctrl *gomock.Controller) *MockHandler {
+	mock := &MockHandler{ctrl: ctrl}
+	mock.recorder = &MockHandlerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockHandler) STUB() *MockHandlerMockRecorder {
+	return &MockHandlerMockRecorder{mock: m, stub: true}
+}
+
+// Fn returns a function that calls the mock.
+func (m *MockHandler) Fn() func_types.Handler {
+	return m.Call
+}
+
+// Call mocks base method.
+func (m *MockHandler) Call(arg0 context.Context, arg1 *func_types.Msg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockHandlerMockRecorder) Call(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockHandler)(nil).Call), arg0, arg1)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockHandler)(nil).Call), arg0, arg1)
+}
+
+// MockLogf is a mock of Logf func type.
+type MockLogf struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogfMockRecorder
+}
+
+// MockLogfMockRecorder is the mock recorder for MockLogf.
+type MockLogfMockRecorder struct {
+	mock *MockLogf
+	stub bool
+}
+
+// NewMockLogf creates a new mock instance.
+func NewMockLogf(ctrl *gomock.Controller) *MockLogf {
+	mock := &MockLogf{ctrl: ctrl}
+	mock.recorder = &MockLogfMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogf) EXPECT() *MockLogfMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockLogf) STUB() *MockLogfMockRecorder {
+	return &MockLogfMockRecorder{mock: m, stub: true}
+}
+
+// Fn returns a function that calls the mock.
+func (m *MockLogf) Fn() func_types.Logf {
+	return m.Call
+}
+
+// Call mocks base method.
+func (m *MockLogf) Call(arg0 string, arg1 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Call", varargs...)
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockLogfMockRecorder) Call(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockLogf)(nil).Call), varargs...)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockLogf)(nil).Call), varargs...)
+}
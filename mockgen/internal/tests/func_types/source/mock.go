@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+
+// Package source is a generated GoMock package.
+package source
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	func_types "github.com/golang/mock/mockgen/internal/tests/func_types"
+)
+
+// MockHandler is a mock of Handler func type.
+type MockHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandlerMockRecorder
+}
+
+// MockHandlerMockRecorder is the mock recorder for MockHandler.
+type MockHandlerMockRecorder struct {
+	mock *MockHandler
+	stub bool
+}
+
+// NewMockHandler creates a new mock instance.
+func NewMockHandler(ctrl *gomock.Controller) *MockHandler {
+	mock := &MockHandler{ctrl: ctrl}
+	mock.recorder = &MockHandlerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockHandler) STUB() *MockHandlerMockRecorder {
+	return &MockHandlerMockRecorder{mock: m, stub: true}
+}
+
+// Fn returns a function that calls the mock.
+func (m *MockHandler) Fn() func_types.Handler {
+	return m.Call
+}
+
+// Call mocks base method.
+func (m *MockHandler) Call(ctx context.Context, msg *func_types.Msg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockHandlerMockRecorder) Call(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockHandler)(nil).Call), ctx, msg)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockHandler)(nil).Call), ctx, msg)
+}
+
+// MockLogf is a mock of Logf func type.
+type MockLogf struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogfMockRecorder
+}
+
+// MockLogfMockRecorder is the mock recorder for MockLogf.
+type MockLogfMockRecorder struct {
+	mock *MockLogf
+	stub bool
+}
+
+// NewMockLogf creates a new mock instance.
+func NewMockLogf(ctrl *gomock.Controller) *MockLogf {
+	mock := &MockLogf{ctrl: ctrl}
+	mock.recorder = &MockLogfMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogf) EXPECT() *MockLogfMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockLogf) STUB() *MockLogfMockRecorder {
+	return &MockLogfMockRecorder{mock: m, stub: true}
+}
+
+// Fn returns a function that calls the mock.
+func (m *MockLogf) Fn() func_types.Logf {
+	return m.Call
+}
+
+// Call mocks base method.
+func (m *MockLogf) Call(format string, args ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{format}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Call", varargs...)
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockLogfMockRecorder) Call(format interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{format}, args...)
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockLogf)(nil).Call), varargs...)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockLogf)(nil).Call), varargs...)
+}
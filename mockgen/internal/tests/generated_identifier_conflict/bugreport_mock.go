@@ -19,12 +19,13 @@ type MockExample struct {
 // MockExampleMockRecorder is the mock recorder for MockExample.
 type MockExampleMockRecorder struct {
 	mock *MockExample
+	stub bool
 }
 
 // NewMockExample creates a new mock instance.
 func NewMockExample(ctrl *gomock.Controller) *MockExample {
 	mock := &MockExample{ctrl: ctrl}
-	mock.recorder = &MockExampleMockRecorder{mock}
+	mock.recorder = &MockExampleMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockExample) EXPECT() *MockExampleMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockExample) STUB() *MockExampleMockRecorder {
+	return &MockExampleMockRecorder{mock: m, stub: true}
+}
+
 // Method mocks base method.
 func (m_2 *MockExample) Method(_m, _mr, m, mr int) {
 	m_2.ctrl.T.Helper()
@@ -42,6 +49,9 @@ func (m_2 *MockExample) Method(_m, _mr, m, mr int) {
 // Method indicates an expected call of Method.
 func (mr_2 *MockExampleMockRecorder) Method(_m, _mr, m, mr interface{}) *gomock.Call {
 	mr_2.mock.ctrl.T.Helper()
+	if mr_2.stub {
+		return mr_2.mock.ctrl.RecordStubWithMethodType(mr_2.mock, "Method", reflect.TypeOf((*MockExample)(nil).Method), _m, _mr, m, mr)
+	}
 	return mr_2.mock.ctrl.RecordCallWithMethodType(mr_2.mock, "Method", reflect.TypeOf((*MockExample)(nil).Method), _m, _mr, m, mr)
 }
 
@@ -59,5 +69,8 @@ func (m *MockExample) VarargMethod(_s, _x, a, ret int, varargs ...int) {
 func (mr *MockExampleMockRecorder) VarargMethod(_s, _x, a, ret interface{}, varargs ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs_2 := append([]interface{}{_s, _x, a, ret}, varargs...)
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "VarargMethod", reflect.TypeOf((*MockExample)(nil).VarargMethod), varargs_2...)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VarargMethod", reflect.TypeOf((*MockExample)(nil).VarargMethod), varargs_2...)
 }
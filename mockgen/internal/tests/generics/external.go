@@ -6,6 +6,7 @@ import (
 )
 
 //go:generate mockgen --source=external.go --destination=source/mock_external_test.go --package source
+//go:generate mockgen --destination=package_mode/mock_external_test.go --package package_mode -package_mode . ExternalConstraint
 
 type ExternalConstraint[I constraints.Integer, F constraints.Float] interface {
 	One(string) string
@@ -4,6 +4,7 @@ import "github.com/golang/mock/mockgen/internal/tests/generics/other"
 
 //go:generate mockgen --source=generics.go --destination=source/mock_generics_test.go --package source
 ////go:generate mockgen --destination=reflect/mock_test.go --package reflect . Bar,Bar2
+//go:generate mockgen --destination=package_mode/mock_generics_test.go --package package_mode -package_mode . Bar,Iface
 
 type Bar[T any, R any] interface {
 	One(string) string
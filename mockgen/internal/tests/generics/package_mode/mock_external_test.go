@@ -0,0 +1,210 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/golang/mock/mockgen/internal/tests/generics (interfaces: ExternalConstraint)
+
+// Package package_mode is a generated GoMock package.
+package package_mode
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	generics "github.com/golang/mock/mockgen/internal/tests/generics"
+	other "github.com/golang/mock/mockgen/internal/tests/generics/other"
+	constraints "golang.org/x/exp/constraints"
+)
+
+// MockExternalConstraint is a mock of ExternalConstraint interface.
+type MockExternalConstraint[I constraints.Integer, F constraints.Float] struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalConstraintMockRecorder[I, F]
+}
+
+// MockExternalConstraintMockRecorder is the mock recorder for MockExternalConstraint.
+type MockExternalConstraintMockRecorder[I constraints.Integer, F constraints.Float] struct {
+	mock *MockExternalConstraint[I, F]
+	stub bool
+}
+
+// NewMockExternalConstraint creates a new mock instance.
+func NewMockExternalConstraint[I constraints.Integer, F constraints.Float](ctrl *gomock.Controller) *MockExternalConstraint[I, F] {
+	mock := &MockExternalConstraint[I, F]{ctrl: ctrl}
+	mock.recorder = &MockExternalConstraintMockRecorder[I, F]{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalConstraint[I, F]) EXPECT() *MockExternalConstraintMockRecorder[I, F] {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockExternalConstraint[I, F]) STUB() *MockExternalConstraintMockRecorder[I, F] {
+	return &MockExternalConstraintMockRecorder[I, F]{mock: m, stub: true}
+}
+
+// Eight mocks base method.
+func (m *MockExternalConstraint[I, F]) Eight(arg0 F) other.Two[I, F] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eight", arg0)
+	ret0 := gomock.Result[other.Two[I, F]](m.ctrl, m, "Eight", ret, 0)
+	return ret0
+}
+
+// Eight indicates an expected call of Eight.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Eight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Eight", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Eight), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eight", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Eight), arg0)
+}
+
+// Five mocks base method.
+func (m *MockExternalConstraint[I, F]) Five(arg0 I) generics.Baz[F] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Five", arg0)
+	ret0 := gomock.Result[generics.Baz[F]](m.ctrl, m, "Five", ret, 0)
+	return ret0
+}
+
+// Five indicates an expected call of Five.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Five(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Five", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Five), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Five", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Five), arg0)
+}
+
+// Four mocks base method.
+func (m *MockExternalConstraint[I, F]) Four(arg0 I) generics.Foo[I, F] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Four", arg0)
+	ret0 := gomock.Result[generics.Foo[I, F]](m.ctrl, m, "Four", ret, 0)
+	return ret0
+}
+
+// Four indicates an expected call of Four.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Four(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Four", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Four), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Four", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Four), arg0)
+}
+
+// Nine mocks base method.
+func (m *MockExternalConstraint[I, F]) Nine(arg0 generics.Iface[I]) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Nine", arg0)
+}
+
+// Nine indicates an expected call of Nine.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Nine(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Nine", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Nine), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nine", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Nine), arg0)
+}
+
+// One mocks base method.
+func (m *MockExternalConstraint[I, F]) One(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "One", arg0)
+	ret0 := gomock.Result[string](m.ctrl, m, "One", ret, 0)
+	return ret0
+}
+
+// One indicates an expected call of One.
+func (mr *MockExternalConstraintMockRecorder[I, F]) One(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "One", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).One), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "One", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).One), arg0)
+}
+
+// Seven mocks base method.
+func (m *MockExternalConstraint[I, F]) Seven(arg0 I) other.One[I] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seven", arg0)
+	ret0 := gomock.Result[other.One[I]](m.ctrl, m, "Seven", ret, 0)
+	return ret0
+}
+
+// Seven indicates an expected call of Seven.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Seven(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Seven", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Seven), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seven", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Seven), arg0)
+}
+
+// Six mocks base method.
+func (m *MockExternalConstraint[I, F]) Six(arg0 I) *generics.Baz[F] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Six", arg0)
+	ret0 := gomock.Result[*generics.Baz[F]](m.ctrl, m, "Six", ret, 0)
+	return ret0
+}
+
+// Six indicates an expected call of Six.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Six(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Six", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Six), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Six", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Six), arg0)
+}
+
+// Ten mocks base method.
+func (m *MockExternalConstraint[I, F]) Ten(arg0 *I) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Ten", arg0)
+}
+
+// Ten indicates an expected call of Ten.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Ten(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Ten", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Ten), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ten", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Ten), arg0)
+}
+
+// Three mocks base method.
+func (m *MockExternalConstraint[I, F]) Three(arg0 I) F {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Three", arg0)
+	ret0 := gomock.Result[F](m.ctrl, m, "Three", ret, 0)
+	return ret0
+}
+
+// Three indicates an expected call of Three.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Three(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Three", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Three), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Three", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Three), arg0)
+}
+
+// Two mocks base method.
+func (m *MockExternalConstraint[I, F]) Two(arg0 I) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Two", arg0)
+	ret0 := gomock.Result[string](m.ctrl, m, "Two", ret, 0)
+	return ret0
+}
+
+// Two indicates an expected call of Two.
+func (mr *MockExternalConstraintMockRecorder[I, F]) Two(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Two", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Two), arg0)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Two", reflect.TypeOf((*MockExternalConstraint[I, F])(nil).Two), arg0)
+}
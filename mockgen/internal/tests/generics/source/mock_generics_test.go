@@ -21,12 +21,13 @@ type MockBar[T any, R any] struct {
 // MockBarMockRecorder is the mock recorder for MockBar.
 type MockBarMockRecorder[T any, R any] struct {
 	mock *MockBar[T, R]
+	stub bool
 }
 
 // NewMockBar creates a new mock instance.
 func NewMockBar[T any, R any](ctrl *gomock.Controller) *MockBar[T, R] {
 	mock := &MockBar[T, R]{ctrl: ctrl}
-	mock.recorder = &MockBarMockRecorder[T, R]{mock}
+	mock.recorder = &MockBarMockRecorder[T, R]{mock: mock}
 	return mock
 }
 
@@ -35,17 +36,26 @@ func (m *MockBar[T, R]) EXPECT() *MockBarMockRecorder[T, R] {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockBar[T, R]) STUB() *MockBarMockRecorder[T, R] {
+	return &MockBarMockRecorder[T, R]{mock: m, stub: true}
+}
+
 // Eight mocks base method.
 func (m *MockBar[T, R]) Eight(arg0 T) other.Two[T, R] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Eight", arg0)
-	ret0, _ := ret[0].(other.Two[T, R])
+	ret0 := gomock.Result[other.Two[T, R]](m.ctrl, m, "Eight", ret, 0)
 	return ret0
 }
 
 // Eight indicates an expected call of Eight.
 func (mr *MockBarMockRecorder[T, R]) Eight(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Eight", reflect.TypeOf((*MockBar[T, R])(nil).Eight), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eight", reflect.TypeOf((*MockBar[T, R])(nil).Eight), arg0)
 }
 
@@ -53,14 +63,17 @@ func (mr *MockBarMockRecorder[T, R]) Eight(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Eighteen() (generics.Iface[*other.Five], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Eighteen")
-	ret0, _ := ret[0].(generics.Iface[*other.Five])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[generics.Iface[*other.Five]](m.ctrl, m, "Eighteen", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Eighteen", ret, 1)
 	return ret0, ret1
 }
 
 // Eighteen indicates an expected call of Eighteen.
 func (mr *MockBarMockRecorder[T, R]) Eighteen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Eighteen", reflect.TypeOf((*MockBar[T, R])(nil).Eighteen))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eighteen", reflect.TypeOf((*MockBar[T, R])(nil).Eighteen))
 }
 
@@ -68,14 +81,17 @@ func (mr *MockBarMockRecorder[T, R]) Eighteen() *gomock.Call {
 func (m *MockBar[T, R]) Eleven() (*other.One[T], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Eleven")
-	ret0, _ := ret[0].(*other.One[T])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[*other.One[T]](m.ctrl, m, "Eleven", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Eleven", ret, 1)
 	return ret0, ret1
 }
 
 // Eleven indicates an expected call of Eleven.
 func (mr *MockBarMockRecorder[T, R]) Eleven() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Eleven", reflect.TypeOf((*MockBar[T, R])(nil).Eleven))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eleven", reflect.TypeOf((*MockBar[T, R])(nil).Eleven))
 }
 
@@ -83,14 +99,17 @@ func (mr *MockBarMockRecorder[T, R]) Eleven() *gomock.Call {
 func (m *MockBar[T, R]) Fifteen() (generics.Iface[generics.StructType], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Fifteen")
-	ret0, _ := ret[0].(generics.Iface[generics.StructType])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[generics.Iface[generics.StructType]](m.ctrl, m, "Fifteen", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Fifteen", ret, 1)
 	return ret0, ret1
 }
 
 // Fifteen indicates an expected call of Fifteen.
 func (mr *MockBarMockRecorder[T, R]) Fifteen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Fifteen", reflect.TypeOf((*MockBar[T, R])(nil).Fifteen))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fifteen", reflect.TypeOf((*MockBar[T, R])(nil).Fifteen))
 }
 
@@ -98,13 +117,16 @@ func (mr *MockBarMockRecorder[T, R]) Fifteen() *gomock.Call {
 func (m *MockBar[T, R]) Five(arg0 T) generics.Baz[T] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Five", arg0)
-	ret0, _ := ret[0].(generics.Baz[T])
+	ret0 := gomock.Result[generics.Baz[T]](m.ctrl, m, "Five", ret, 0)
 	return ret0
 }
 
 // Five indicates an expected call of Five.
 func (mr *MockBarMockRecorder[T, R]) Five(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Five", reflect.TypeOf((*MockBar[T, R])(nil).Five), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Five", reflect.TypeOf((*MockBar[T, R])(nil).Five), arg0)
 }
 
@@ -112,13 +134,16 @@ func (mr *MockBarMockRecorder[T, R]) Five(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Four(arg0 T) generics.Foo[T, R] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Four", arg0)
-	ret0, _ := ret[0].(generics.Foo[T, R])
+	ret0 := gomock.Result[generics.Foo[T, R]](m.ctrl, m, "Four", ret, 0)
 	return ret0
 }
 
 // Four indicates an expected call of Four.
 func (mr *MockBarMockRecorder[T, R]) Four(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Four", reflect.TypeOf((*MockBar[T, R])(nil).Four), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Four", reflect.TypeOf((*MockBar[T, R])(nil).Four), arg0)
 }
 
@@ -126,14 +151,17 @@ func (mr *MockBarMockRecorder[T, R]) Four(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Fourteen() (*generics.Foo[generics.StructType, generics.StructType2], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Fourteen")
-	ret0, _ := ret[0].(*generics.Foo[generics.StructType, generics.StructType2])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[*generics.Foo[generics.StructType, generics.StructType2]](m.ctrl, m, "Fourteen", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Fourteen", ret, 1)
 	return ret0, ret1
 }
 
 // Fourteen indicates an expected call of Fourteen.
 func (mr *MockBarMockRecorder[T, R]) Fourteen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Fourteen", reflect.TypeOf((*MockBar[T, R])(nil).Fourteen))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fourteen", reflect.TypeOf((*MockBar[T, R])(nil).Fourteen))
 }
 
@@ -146,6 +174,9 @@ func (m *MockBar[T, R]) Nine(arg0 generics.Iface[T]) {
 // Nine indicates an expected call of Nine.
 func (mr *MockBarMockRecorder[T, R]) Nine(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Nine", reflect.TypeOf((*MockBar[T, R])(nil).Nine), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nine", reflect.TypeOf((*MockBar[T, R])(nil).Nine), arg0)
 }
 
@@ -153,13 +184,16 @@ func (mr *MockBarMockRecorder[T, R]) Nine(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Nineteen() generics.AliasType {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Nineteen")
-	ret0, _ := ret[0].(generics.AliasType)
+	ret0 := gomock.Result[generics.AliasType](m.ctrl, m, "Nineteen", ret, 0)
 	return ret0
 }
 
 // Nineteen indicates an expected call of Nineteen.
 func (mr *MockBarMockRecorder[T, R]) Nineteen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Nineteen", reflect.TypeOf((*MockBar[T, R])(nil).Nineteen))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nineteen", reflect.TypeOf((*MockBar[T, R])(nil).Nineteen))
 }
 
@@ -167,13 +201,16 @@ func (mr *MockBarMockRecorder[T, R]) Nineteen() *gomock.Call {
 func (m *MockBar[T, R]) One(arg0 string) string {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "One", arg0)
-	ret0, _ := ret[0].(string)
+	ret0 := gomock.Result[string](m.ctrl, m, "One", ret, 0)
 	return ret0
 }
 
 // One indicates an expected call of One.
 func (mr *MockBarMockRecorder[T, R]) One(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "One", reflect.TypeOf((*MockBar[T, R])(nil).One), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "One", reflect.TypeOf((*MockBar[T, R])(nil).One), arg0)
 }
 
@@ -181,13 +218,16 @@ func (mr *MockBarMockRecorder[T, R]) One(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Seven(arg0 T) other.One[T] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Seven", arg0)
-	ret0, _ := ret[0].(other.One[T])
+	ret0 := gomock.Result[other.One[T]](m.ctrl, m, "Seven", ret, 0)
 	return ret0
 }
 
 // Seven indicates an expected call of Seven.
 func (mr *MockBarMockRecorder[T, R]) Seven(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Seven", reflect.TypeOf((*MockBar[T, R])(nil).Seven), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seven", reflect.TypeOf((*MockBar[T, R])(nil).Seven), arg0)
 }
 
@@ -195,14 +235,17 @@ func (mr *MockBarMockRecorder[T, R]) Seven(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Seventeen() (*generics.Foo[other.Three, other.Four], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Seventeen")
-	ret0, _ := ret[0].(*generics.Foo[other.Three, other.Four])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[*generics.Foo[other.Three, other.Four]](m.ctrl, m, "Seventeen", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Seventeen", ret, 1)
 	return ret0, ret1
 }
 
 // Seventeen indicates an expected call of Seventeen.
 func (mr *MockBarMockRecorder[T, R]) Seventeen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Seventeen", reflect.TypeOf((*MockBar[T, R])(nil).Seventeen))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seventeen", reflect.TypeOf((*MockBar[T, R])(nil).Seventeen))
 }
 
@@ -210,13 +253,16 @@ func (mr *MockBarMockRecorder[T, R]) Seventeen() *gomock.Call {
 func (m *MockBar[T, R]) Six(arg0 T) *generics.Baz[T] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Six", arg0)
-	ret0, _ := ret[0].(*generics.Baz[T])
+	ret0 := gomock.Result[*generics.Baz[T]](m.ctrl, m, "Six", ret, 0)
 	return ret0
 }
 
 // Six indicates an expected call of Six.
 func (mr *MockBarMockRecorder[T, R]) Six(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Six", reflect.TypeOf((*MockBar[T, R])(nil).Six), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Six", reflect.TypeOf((*MockBar[T, R])(nil).Six), arg0)
 }
 
@@ -224,14 +270,17 @@ func (mr *MockBarMockRecorder[T, R]) Six(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Sixteen() (generics.Baz[other.Three], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Sixteen")
-	ret0, _ := ret[0].(generics.Baz[other.Three])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[generics.Baz[other.Three]](m.ctrl, m, "Sixteen", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Sixteen", ret, 1)
 	return ret0, ret1
 }
 
 // Sixteen indicates an expected call of Sixteen.
 func (mr *MockBarMockRecorder[T, R]) Sixteen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Sixteen", reflect.TypeOf((*MockBar[T, R])(nil).Sixteen))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sixteen", reflect.TypeOf((*MockBar[T, R])(nil).Sixteen))
 }
 
@@ -244,6 +293,9 @@ func (m *MockBar[T, R]) Ten(arg0 *T) {
 // Ten indicates an expected call of Ten.
 func (mr *MockBarMockRecorder[T, R]) Ten(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Ten", reflect.TypeOf((*MockBar[T, R])(nil).Ten), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ten", reflect.TypeOf((*MockBar[T, R])(nil).Ten), arg0)
 }
 
@@ -251,14 +303,17 @@ func (mr *MockBarMockRecorder[T, R]) Ten(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Thirteen() (generics.Baz[generics.StructType], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Thirteen")
-	ret0, _ := ret[0].(generics.Baz[generics.StructType])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[generics.Baz[generics.StructType]](m.ctrl, m, "Thirteen", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Thirteen", ret, 1)
 	return ret0, ret1
 }
 
 // Thirteen indicates an expected call of Thirteen.
 func (mr *MockBarMockRecorder[T, R]) Thirteen() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Thirteen", reflect.TypeOf((*MockBar[T, R])(nil).Thirteen))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Thirteen", reflect.TypeOf((*MockBar[T, R])(nil).Thirteen))
 }
 
@@ -266,13 +321,16 @@ func (mr *MockBarMockRecorder[T, R]) Thirteen() *gomock.Call {
 func (m *MockBar[T, R]) Three(arg0 T) R {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Three", arg0)
-	ret0, _ := ret[0].(R)
+	ret0 := gomock.Result[R](m.ctrl, m, "Three", ret, 0)
 	return ret0
 }
 
 // Three indicates an expected call of Three.
 func (mr *MockBarMockRecorder[T, R]) Three(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Three", reflect.TypeOf((*MockBar[T, R])(nil).Three), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Three", reflect.TypeOf((*MockBar[T, R])(nil).Three), arg0)
 }
 
@@ -280,14 +338,17 @@ func (mr *MockBarMockRecorder[T, R]) Three(arg0 interface{}) *gomock.Call {
 func (m *MockBar[T, R]) Twelve() (*other.Two[T, R], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Twelve")
-	ret0, _ := ret[0].(*other.Two[T, R])
-	ret1, _ := ret[1].(error)
+	ret0 := gomock.Result[*other.Two[T, R]](m.ctrl, m, "Twelve", ret, 0)
+	ret1 := gomock.Result[error](m.ctrl, m, "Twelve", ret, 1)
 	return ret0, ret1
 }
 
 // Twelve indicates an expected call of Twelve.
 func (mr *MockBarMockRecorder[T, R]) Twelve() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Twelve", reflect.TypeOf((*MockBar[T, R])(nil).Twelve))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Twelve", reflect.TypeOf((*MockBar[T, R])(nil).Twelve))
 }
 
@@ -295,13 +356,16 @@ func (mr *MockBarMockRecorder[T, R]) Twelve() *gomock.Call {
 func (m *MockBar[T, R]) Two(arg0 T) string {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Two", arg0)
-	ret0, _ := ret[0].(string)
+	ret0 := gomock.Result[string](m.ctrl, m, "Two", ret, 0)
 	return ret0
 }
 
 // Two indicates an expected call of Two.
 func (mr *MockBarMockRecorder[T, R]) Two(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Two", reflect.TypeOf((*MockBar[T, R])(nil).Two), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Two", reflect.TypeOf((*MockBar[T, R])(nil).Two), arg0)
 }
 
@@ -314,12 +378,13 @@ type MockIface[T any] struct {
 // MockIfaceMockRecorder is the mock recorder for MockIface.
 type MockIfaceMockRecorder[T any] struct {
 	mock *MockIface[T]
+	stub bool
 }
 
 // NewMockIface creates a new mock instance.
 func NewMockIface[T any](ctrl *gomock.Controller) *MockIface[T] {
 	mock := &MockIface[T]{ctrl: ctrl}
-	mock.recorder = &MockIfaceMockRecorder[T]{mock}
+	mock.recorder = &MockIfaceMockRecorder[T]{mock: mock}
 	return mock
 }
 
@@ -327,3 +392,9 @@ func NewMockIface[T any](ctrl *gomock.Controller) *MockIface[T] {
 func (m *MockIface[T]) EXPECT() *MockIfaceMockRecorder[T] {
 	return m.recorder
 }
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockIface[T]) STUB() *MockIfaceMockRecorder[T] {
+	return &MockIfaceMockRecorder[T]{mock: m, stub: true}
+}
@@ -21,12 +21,13 @@ type MockSource struct {
 // MockSourceMockRecorder is the mock recorder for MockSource.
 type MockSourceMockRecorder struct {
 	mock *MockSource
+	stub bool
 }
 
 // NewMockSource creates a new mock instance.
 func NewMockSource(ctrl *gomock.Controller) *MockSource {
 	mock := &MockSource{ctrl: ctrl}
-	mock.recorder = &MockSourceMockRecorder{mock}
+	mock.recorder = &MockSourceMockRecorder{mock: mock}
 	return mock
 }
 
@@ -35,6 +36,12 @@ func (m *MockSource) EXPECT() *MockSourceMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockSource) STUB() *MockSourceMockRecorder {
+	return &MockSourceMockRecorder{mock: m, stub: true}
+}
+
 // Bar mocks base method.
 func (m *MockSource) Bar() Baz {
 	m.ctrl.T.Helper()
@@ -46,6 +53,9 @@ func (m *MockSource) Bar() Baz {
 // Bar indicates an expected call of Bar.
 func (mr *MockSourceMockRecorder) Bar() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockSource)(nil).Bar))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bar", reflect.TypeOf((*MockSource)(nil).Bar))
 }
 
@@ -60,6 +70,9 @@ func (m *MockSource) Error() string {
 // Error indicates an expected call of Error.
 func (mr *MockSourceMockRecorder) Error() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSource)(nil).Error))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSource)(nil).Error))
 }
 
@@ -74,6 +87,9 @@ func (m *MockSource) Ersatz() ersatz.Return {
 // Ersatz indicates an expected call of Ersatz.
 func (mr *MockSourceMockRecorder) Ersatz() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Ersatz", reflect.TypeOf((*MockSource)(nil).Ersatz))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ersatz", reflect.TypeOf((*MockSource)(nil).Ersatz))
 }
 
@@ -88,5 +104,8 @@ func (m *MockSource) OtherErsatz() ersatz0.Return {
 // OtherErsatz indicates an expected call of OtherErsatz.
 func (mr *MockSourceMockRecorder) OtherErsatz() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "OtherErsatz", reflect.TypeOf((*MockSource)(nil).OtherErsatz))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OtherErsatz", reflect.TypeOf((*MockSource)(nil).OtherErsatz))
 }
@@ -20,12 +20,13 @@ type MockNet struct {
 // MockNetMockRecorder is the mock recorder for MockNet.
 type MockNetMockRecorder struct {
 	mock *MockNet
+	stub bool
 }
 
 // NewMockNet creates a new mock instance.
 func NewMockNet(ctrl *gomock.Controller) *MockNet {
 	mock := &MockNet{ctrl: ctrl}
-	mock.recorder = &MockNetMockRecorder{mock}
+	mock.recorder = &MockNetMockRecorder{mock: mock}
 	return mock
 }
 
@@ -34,6 +35,12 @@ func (m *MockNet) EXPECT() *MockNetMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockNet) STUB() *MockNetMockRecorder {
+	return &MockNetMockRecorder{mock: m, stub: true}
+}
+
 // Header mocks base method.
 func (m *MockNet) Header() http.Header {
 	m.ctrl.T.Helper()
@@ -45,6 +52,9 @@ func (m *MockNet) Header() http.Header {
 // Header indicates an expected call of Header.
 func (mr *MockNetMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockNet)(nil).Header))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockNet)(nil).Header))
 }
 
@@ -60,6 +70,9 @@ func (m *MockNet) Write(arg0 []byte) (int, error) {
 // Write indicates an expected call of Write.
 func (mr *MockNetMockRecorder) Write(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockNet)(nil).Write), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockNet)(nil).Write), arg0)
 }
 
@@ -72,5 +85,8 @@ func (m *MockNet) WriteHeader(statusCode int) {
 // WriteHeader indicates an expected call of WriteHeader.
 func (mr *MockNetMockRecorder) WriteHeader(statusCode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "WriteHeader", reflect.TypeOf((*MockNet)(nil).WriteHeader), statusCode)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteHeader", reflect.TypeOf((*MockNet)(nil).WriteHeader), statusCode)
 }
@@ -19,12 +19,13 @@ type MockS struct {
 // MockSMockRecorder is the mock recorder for MockS.
 type MockSMockRecorder struct {
 	mock *MockS
+	stub bool
 }
 
 // NewMockS creates a new mock instance.
 func NewMockS(ctrl *gomock.Controller) *MockS {
 	mock := &MockS{ctrl: ctrl}
-	mock.recorder = &MockSMockRecorder{mock}
+	mock.recorder = &MockSMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockS) EXPECT() *MockSMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockS) STUB() *MockSMockRecorder {
+	return &MockSMockRecorder{mock: m, stub: true}
+}
+
 // F mocks base method.
 func (m *MockS) F(arg0 X) {
 	m.ctrl.T.Helper()
@@ -42,5 +49,8 @@ func (m *MockS) F(arg0 X) {
 // F indicates an expected call of F.
 func (mr *MockSMockRecorder) F(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "F", reflect.TypeOf((*MockS)(nil).F), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "F", reflect.TypeOf((*MockS)(nil).F), arg0)
 }
@@ -20,12 +20,13 @@ type MockIntf struct {
 // MockIntfMockRecorder is the mock recorder for MockIntf.
 type MockIntfMockRecorder struct {
 	mock *MockIntf
+	stub bool
 }
 
 // NewMockIntf creates a new mock instance.
 func NewMockIntf(ctrl *gomock.Controller) *MockIntf {
 	mock := &MockIntf{ctrl: ctrl}
-	mock.recorder = &MockIntfMockRecorder{mock}
+	mock.recorder = &MockIntfMockRecorder{mock: mock}
 	return mock
 }
 
@@ -34,6 +35,12 @@ func (m *MockIntf) EXPECT() *MockIntfMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockIntf) STUB() *MockIntfMockRecorder {
+	return &MockIntfMockRecorder{mock: m, stub: true}
+}
+
 // F mocks base method.
 func (m *MockIntf) F() pkg.Arg {
 	m.ctrl.T.Helper()
@@ -45,5 +52,8 @@ func (m *MockIntf) F() pkg.Arg {
 // F indicates an expected call of F.
 func (mr *MockIntfMockRecorder) F() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "F", reflect.TypeOf((*MockIntf)(nil).F))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "F", reflect.TypeOf((*MockIntf)(nil).F))
 }
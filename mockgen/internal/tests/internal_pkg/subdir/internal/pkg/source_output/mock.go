@@ -20,12 +20,13 @@ type MockArg struct {
 // MockArgMockRecorder is the mock recorder for MockArg.
 type MockArgMockRecorder struct {
 	mock *MockArg
+	stub bool
 }
 
 // NewMockArg creates a new mock instance.
 func NewMockArg(ctrl *gomock.Controller) *MockArg {
 	mock := &MockArg{ctrl: ctrl}
-	mock.recorder = &MockArgMockRecorder{mock}
+	mock.recorder = &MockArgMockRecorder{mock: mock}
 	return mock
 }
 
@@ -34,6 +35,12 @@ func (m *MockArg) EXPECT() *MockArgMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockArg) STUB() *MockArgMockRecorder {
+	return &MockArgMockRecorder{mock: m, stub: true}
+}
+
 // Foo mocks base method.
 func (m *MockArg) Foo() int {
 	m.ctrl.T.Helper()
@@ -45,6 +52,9 @@ func (m *MockArg) Foo() int {
 // Foo indicates an expected call of Foo.
 func (mr *MockArgMockRecorder) Foo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Foo", reflect.TypeOf((*MockArg)(nil).Foo))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Foo", reflect.TypeOf((*MockArg)(nil).Foo))
 }
 
@@ -57,12 +67,13 @@ type MockIntf struct {
 // MockIntfMockRecorder is the mock recorder for MockIntf.
 type MockIntfMockRecorder struct {
 	mock *MockIntf
+	stub bool
 }
 
 // NewMockIntf creates a new mock instance.
 func NewMockIntf(ctrl *gomock.Controller) *MockIntf {
 	mock := &MockIntf{ctrl: ctrl}
-	mock.recorder = &MockIntfMockRecorder{mock}
+	mock.recorder = &MockIntfMockRecorder{mock: mock}
 	return mock
 }
 
@@ -71,6 +82,12 @@ func (m *MockIntf) EXPECT() *MockIntfMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockIntf) STUB() *MockIntfMockRecorder {
+	return &MockIntfMockRecorder{mock: m, stub: true}
+}
+
 // F mocks base method.
 func (m *MockIntf) F() pkg.Arg {
 	m.ctrl.T.Helper()
@@ -82,5 +99,8 @@ func (m *MockIntf) F() pkg.Arg {
 // F indicates an expected call of F.
 func (mr *MockIntfMockRecorder) F() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "F", reflect.TypeOf((*MockIntf)(nil).F))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "F", reflect.TypeOf((*MockIntf)(nil).F))
 }
@@ -20,12 +20,13 @@ type MockBar struct {
 // MockBarMockRecorder is the mock recorder for MockBar.
 type MockBarMockRecorder struct {
 	mock *MockBar
+	stub bool
 }
 
 // NewMockBar creates a new mock instance.
 func NewMockBar(ctrl *gomock.Controller) *MockBar {
 	mock := &MockBar{ctrl: ctrl}
-	mock.recorder = &MockBarMockRecorder{mock}
+	mock.recorder = &MockBarMockRecorder{mock: mock}
 	return mock
 }
 
@@ -34,6 +35,12 @@ func (m *MockBar) EXPECT() *MockBarMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockBar) STUB() *MockBarMockRecorder {
+	return &MockBarMockRecorder{mock: m, stub: true}
+}
+
 // Baz mocks base method.
 func (m *MockBar) Baz(arg0 source.Foo) {
 	m.ctrl.T.Helper()
@@ -43,5 +50,8 @@ func (m *MockBar) Baz(arg0 source.Foo) {
 // Baz indicates an expected call of Baz.
 func (mr *MockBarMockRecorder) Baz(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Baz", reflect.TypeOf((*MockBar)(nil).Baz), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Baz", reflect.TypeOf((*MockBar)(nil).Baz), arg0)
 }
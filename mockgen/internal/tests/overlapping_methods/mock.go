@@ -19,12 +19,13 @@ type MockReadWriteCloser struct {
 // MockReadWriteCloserMockRecorder is the mock recorder for MockReadWriteCloser.
 type MockReadWriteCloserMockRecorder struct {
 	mock *MockReadWriteCloser
+	stub bool
 }
 
 // NewMockReadWriteCloser creates a new mock instance.
 func NewMockReadWriteCloser(ctrl *gomock.Controller) *MockReadWriteCloser {
 	mock := &MockReadWriteCloser{ctrl: ctrl}
-	mock.recorder = &MockReadWriteCloserMockRecorder{mock}
+	mock.recorder = &MockReadWriteCloserMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockReadWriteCloser) EXPECT() *MockReadWriteCloserMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockReadWriteCloser) STUB() *MockReadWriteCloserMockRecorder {
+	return &MockReadWriteCloserMockRecorder{mock: m, stub: true}
+}
+
 // Close mocks base method.
 func (m *MockReadWriteCloser) Close() error {
 	m.ctrl.T.Helper()
@@ -44,6 +51,9 @@ func (m *MockReadWriteCloser) Close() error {
 // Close indicates an expected call of Close.
 func (mr *MockReadWriteCloserMockRecorder) Close() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadWriteCloser)(nil).Close))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReadWriteCloser)(nil).Close))
 }
 
@@ -59,6 +69,9 @@ func (m *MockReadWriteCloser) Read(arg0 []byte) (int, error) {
 // Read indicates an expected call of Read.
 func (mr *MockReadWriteCloserMockRecorder) Read(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadWriteCloser)(nil).Read), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReadWriteCloser)(nil).Read), arg0)
 }
 
@@ -74,5 +87,8 @@ func (m *MockReadWriteCloser) Write(arg0 []byte) (int, error) {
 // Write indicates an expected call of Write.
 func (mr *MockReadWriteCloserMockRecorder) Write(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockReadWriteCloser)(nil).Write), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockReadWriteCloser)(nil).Write), arg0)
 }
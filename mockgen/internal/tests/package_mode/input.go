@@ -0,0 +1,31 @@
+package package_mode
+
+//go:generate mockgen -package_mode -destination mock.go -package package_mode . Store,Alias,closer,Hook
+
+import "io"
+
+// Store reads and writes values.
+type Store interface {
+	io.Closer
+	reader
+	Put(key string, value []byte, opts ...Option) error
+}
+
+type reader interface {
+	Get(key string) ([]byte, error)
+}
+
+// Option configures a Put.
+type Option struct {
+	TTL int
+}
+
+// Alias is an alias of Store.
+type Alias = Store
+
+type closer interface {
+	close(force bool) error
+}
+
+// Hook is called after a Put.
+type Hook func(key string, err error)
@@ -0,0 +1,27 @@
+package package_mode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+var (
+	_ Store  = &MockStore{}
+	_ Alias  = &MockAlias{}
+	_ closer = &Mockcloser{}
+)
+
+func TestMockStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errFull := errors.New("full")
+
+	s := NewMockStore(ctrl)
+	s.EXPECT().Put("k", []byte("v"), Option{TTL: 1}).Return(errFull)
+	hook := NewMockHook(ctrl)
+	hook.EXPECT().Call("k", errFull)
+
+	var h Hook = hook.Fn()
+	h("k", s.Put("k", []byte("v"), Option{TTL: 1}))
+}
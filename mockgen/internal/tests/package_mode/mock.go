@@ -0,0 +1,282 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/golang/mock/mockgen/internal/tests/package_mode (interfaces: Store,Alias,closer,Hook)
+
+// Package package_mode is a generated GoMock package.
+package package_mode
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+	stub bool
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockStore) STUB() *MockStoreMockRecorder {
+	return &MockStoreMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockStore) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close))
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockStore) Put(key string, value []byte, opts ...Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{key, value}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Put", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStoreMockRecorder) Put(key, value interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{key, value}, opts...)
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), varargs...)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), varargs...)
+}
+
+// MockAlias is a mock of Alias interface.
+type MockAlias struct {
+	ctrl     *gomock.Controller
+	recorder *MockAliasMockRecorder
+}
+
+// MockAliasMockRecorder is the mock recorder for MockAlias.
+type MockAliasMockRecorder struct {
+	mock *MockAlias
+	stub bool
+}
+
+// NewMockAlias creates a new mock instance.
+func NewMockAlias(ctrl *gomock.Controller) *MockAlias {
+	mock := &MockAlias{ctrl: ctrl}
+	mock.recorder = &MockAliasMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlias) EXPECT() *MockAliasMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockAlias) STUB() *MockAliasMockRecorder {
+	return &MockAliasMockRecorder{mock: m, stub: true}
+}
+
+// Close mocks base method.
+func (m *MockAlias) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAliasMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAlias)(nil).Close))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAlias)(nil).Close))
+}
+
+// Get mocks base method.
+func (m *MockAlias) Get(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAliasMockRecorder) Get(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAlias)(nil).Get), key)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAlias)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockAlias) Put(key string, value []byte, opts ...Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{key, value}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Put", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockAliasMockRecorder) Put(key, value interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{key, value}, opts...)
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockAlias)(nil).Put), varargs...)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockAlias)(nil).Put), varargs...)
+}
+
+// Mockcloser is a mock of closer interface.
+type Mockcloser struct {
+	ctrl     *gomock.Controller
+	recorder *MockcloserMockRecorder
+}
+
+// MockcloserMockRecorder is the mock recorder for Mockcloser.
+type MockcloserMockRecorder struct {
+	mock *Mockcloser
+	stub bool
+}
+
+// NewMockcloser creates a new mock instance.
+func NewMockcloser(ctrl *gomock.Controller) *Mockcloser {
+	mock := &Mockcloser{ctrl: ctrl}
+	mock.recorder = &MockcloserMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockcloser) EXPECT() *MockcloserMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *Mockcloser) STUB() *MockcloserMockRecorder {
+	return &MockcloserMockRecorder{mock: m, stub: true}
+}
+
+// close mocks base method.
+func (m *Mockcloser) close(force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "close", force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// close indicates an expected call of close.
+func (mr *MockcloserMockRecorder) close(force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "close", reflect.TypeOf((*Mockcloser)(nil).close), force)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "close", reflect.TypeOf((*Mockcloser)(nil).close), force)
+}
+
+// MockHook is a mock of Hook func type.
+type MockHook struct {
+	ctrl     *gomock.Controller
+	recorder *MockHookMockRecorder
+}
+
+// MockHookMockRecorder is the mock recorder for MockHook.
+type MockHookMockRecorder struct {
+	mock *MockHook
+	stub bool
+}
+
+// NewMockHook creates a new mock instance.
+func NewMockHook(ctrl *gomock.Controller) *MockHook {
+	mock := &MockHook{ctrl: ctrl}
+	mock.recorder = &MockHookMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHook) EXPECT() *MockHookMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockHook) STUB() *MockHookMockRecorder {
+	return &MockHookMockRecorder{mock: m, stub: true}
+}
+
+// Fn returns a function that calls the mock.
+func (m *MockHook) Fn() Hook {
+	return m.Call
+}
+
+// Call mocks base method.
+func (m *MockHook) Call(key string, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Call", key, err)
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockHookMockRecorder) Call(key, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockHook)(nil).Call), key, err)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockHook)(nil).Call), key, err)
+}
@@ -0,0 +1,16 @@
+package clock
+
+// Time is a point in time, in seconds.
+type Time int64
+
+type (
+	// Clock tells the time.
+	//mockgen:generate destination=clock_mock_test.go package=clock
+	Clock interface {
+		Now() Time
+	}
+
+	// AfterFunc calls f at t.
+	//mockgen:generate destination=clock_mock_test.go package=clock
+	AfterFunc func(t Time, f func())
+)
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/golang/mock/mockgen/internal/tests/scan_mode/clock (interfaces: Clock,AfterFunc)
+
+// Package clock is a generated GoMock package.
+package clock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClock is a mock of Clock interface.
+type MockClock struct {
+	ctrl     *gomock.Controller
+	recorder *MockClockMockRecorder
+}
+
+// MockClockMockRecorder is the mock recorder for MockClock.
+type MockClockMockRecorder struct {
+	mock *MockClock
+	stub bool
+}
+
+// NewMockClock creates a new mock instance.
+func NewMockClock(ctrl *gomock.Controller) *MockClock {
+	mock := &MockClock{ctrl: ctrl}
+	mock.recorder = &MockClockMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClock) EXPECT() *MockClockMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockClock) STUB() *MockClockMockRecorder {
+	return &MockClockMockRecorder{mock: m, stub: true}
+}
+
+// Now mocks base method.
+func (m *MockClock) Now() Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockClockMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockClock)(nil).Now))
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*MockClock)(nil).Now))
+}
+
+// MockAfterFunc is a mock of AfterFunc func type.
+type MockAfterFunc struct {
+	ctrl     *gomock.Controller
+	recorder *MockAfterFuncMockRecorder
+}
+
+// MockAfterFuncMockRecorder is the mock recorder for MockAfterFunc.
+type MockAfterFuncMockRecorder struct {
+	mock *MockAfterFunc
+	stub bool
+}
+
+// NewMockAfterFunc creates a new mock instance.
+func NewMockAfterFunc(ctrl *gomock.Controller) *MockAfterFunc {
+	mock := &MockAfterFunc{ctrl: ctrl}
+	mock.recorder = &MockAfterFuncMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAfterFunc) EXPECT() *MockAfterFuncMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockAfterFunc) STUB() *MockAfterFuncMockRecorder {
+	return &MockAfterFuncMockRecorder{mock: m, stub: true}
+}
+
+// Fn returns a function that calls the mock.
+func (m *MockAfterFunc) Fn() AfterFunc {
+	return m.Call
+}
+
+// Call mocks base method.
+func (m *MockAfterFunc) Call(t Time, f func()) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Call", t, f)
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockAfterFuncMockRecorder) Call(t, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockAfterFunc)(nil).Call), t, f)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockAfterFunc)(nil).Call), t, f)
+}
@@ -0,0 +1,21 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+var _ Clock = &MockClock{}
+
+func TestMockAfterFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	c := NewMockClock(ctrl)
+	c.EXPECT().Now().Return(Time(10))
+	afterFunc := NewMockAfterFunc(ctrl)
+	afterFunc.EXPECT().Call(Time(15), gomock.Any())
+
+	var after AfterFunc = afterFunc.Fn()
+	after(c.Now()+5, func() {})
+}
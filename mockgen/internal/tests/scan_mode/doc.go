@@ -0,0 +1,5 @@
+// Package scan_mode tests the generation of the mocks of annotated interfaces
+// of several packages in one run.
+package scan_mode
+
+//go:generate mockgen -scan ./...
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/golang/mock/mockgen/internal/tests/scan_mode/store (interfaces: Store,Expiring)
+
+// Package mock_store is a generated GoMock package.
+package mock_store
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	clock "github.com/golang/mock/mockgen/internal/tests/scan_mode/clock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+	stub bool
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockStore) STUB() *MockStoreMockRecorder {
+	return &MockStoreMockRecorder{mock: m, stub: true}
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockStore) Put(key string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStoreMockRecorder) Put(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), key, value)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), key, value)
+}
+
+// FakeExpiring is a mock of Expiring interface.
+type FakeExpiring struct {
+	ctrl     *gomock.Controller
+	recorder *FakeExpiringMockRecorder
+}
+
+// FakeExpiringMockRecorder is the mock recorder for FakeExpiring.
+type FakeExpiringMockRecorder struct {
+	mock *FakeExpiring
+	stub bool
+}
+
+// NewFakeExpiring creates a new mock instance.
+func NewFakeExpiring(ctrl *gomock.Controller) *FakeExpiring {
+	mock := &FakeExpiring{ctrl: ctrl}
+	mock.recorder = &FakeExpiringMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *FakeExpiring) EXPECT() *FakeExpiringMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *FakeExpiring) STUB() *FakeExpiringMockRecorder {
+	return &FakeExpiringMockRecorder{mock: m, stub: true}
+}
+
+// Expire mocks base method.
+func (m *FakeExpiring) Expire(key string, at clock.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Expire", key, at)
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *FakeExpiringMockRecorder) Expire(key, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Expire", reflect.TypeOf((*FakeExpiring)(nil).Expire), key, at)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*FakeExpiring)(nil).Expire), key, at)
+}
+
+// Get mocks base method.
+func (m *FakeExpiring) Get(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *FakeExpiringMockRecorder) Get(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Get", reflect.TypeOf((*FakeExpiring)(nil).Get), key)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*FakeExpiring)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *FakeExpiring) Put(key string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *FakeExpiringMockRecorder) Put(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Put", reflect.TypeOf((*FakeExpiring)(nil).Put), key, value)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*FakeExpiring)(nil).Put), key, value)
+}
@@ -0,0 +1,24 @@
+package store
+
+import "github.com/golang/mock/mockgen/internal/tests/scan_mode/clock"
+
+// Store reads and writes values.
+//
+//mockgen:generate destination=mock_store/mock.go
+type Store interface {
+	Get(key string) ([]byte, error)
+	Put(key string, value []byte) error
+}
+
+// Expiring is a Store of values that expire.
+//
+//mockgen:generate destination=mock_store/mock.go name=FakeExpiring
+type Expiring interface {
+	Store
+	Expire(key string, at clock.Time)
+}
+
+// Helper isn't annotated, so no mock is generated.
+type Helper interface {
+	Help()
+}
@@ -0,0 +1,28 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/mock/mockgen/internal/tests/scan_mode/store"
+	"github.com/golang/mock/mockgen/internal/tests/scan_mode/store/mock_store"
+)
+
+var (
+	_ store.Store    = &mock_store.MockStore{}
+	_ store.Expiring = &mock_store.FakeExpiring{}
+)
+
+func TestFakeExpiring(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	s := mock_store.NewFakeExpiring(ctrl)
+	s.EXPECT().Expire("k", gomock.Any())
+	s.EXPECT().Get("k").Return(nil, nil)
+
+	var e store.Expiring = s
+	e.Expire("k", 10)
+	if _, err := e.Get("k"); err != nil {
+		t.Errorf("Get: %v", err)
+	}
+}
@@ -19,12 +19,13 @@ type MockMethods struct {
 // MockMethodsMockRecorder is the mock recorder for MockMethods.
 type MockMethodsMockRecorder struct {
 	mock *MockMethods
+	stub bool
 }
 
 // NewMockMethods creates a new mock instance.
 func NewMockMethods(ctrl *gomock.Controller) *MockMethods {
 	mock := &MockMethods{ctrl: ctrl}
-	mock.recorder = &MockMethodsMockRecorder{mock}
+	mock.recorder = &MockMethodsMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockMethods) EXPECT() *MockMethodsMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockMethods) STUB() *MockMethodsMockRecorder {
+	return &MockMethodsMockRecorder{mock: m, stub: true}
+}
+
 // getInfo mocks base method.
 func (m *MockMethods) getInfo() Info {
 	m.ctrl.T.Helper()
@@ -44,5 +51,8 @@ func (m *MockMethods) getInfo() Info {
 // getInfo indicates an expected call of getInfo.
 func (mr *MockMethodsMockRecorder) getInfo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "getInfo", reflect.TypeOf((*MockMethods)(nil).getInfo))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getInfo", reflect.TypeOf((*MockMethods)(nil).getInfo))
 }
@@ -0,0 +1,23 @@
+package source_interfaces
+
+//go:generate mockgen -source input.go -destination mock.go -package source_interfaces -interfaces Store*,Notifier -exclude_interfaces *Internal
+
+// StoreReader, StoreWriter and Notifier are mocked.
+type StoreReader interface {
+	Get(key string) ([]byte, error)
+}
+
+type StoreWriter interface {
+	Put(key string, value []byte) error
+}
+
+type Notifier interface {
+	Notify(event string)
+}
+
+// StoreInternal is excluded, and Listener isn't selected.
+type StoreInternal interface {
+	Compact() error
+}
+
+type Listener func(event string)
@@ -0,0 +1,14 @@
+package source_interfaces
+
+var (
+	_ StoreReader = &MockStoreReader{}
+	_ StoreWriter = &MockStoreWriter{}
+	_ Notifier    = &MockNotifier{}
+)
+
+// The excluded and unselected types aren't mocked, so mocks of them can be
+// declared here.
+type (
+	MockStoreInternal struct{}
+	MockListener      struct{}
+)
@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: input.go
+
+// Package source_interfaces is a generated GoMock package.
+package source_interfaces
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStoreReader is a mock of StoreReader interface.
+type MockStoreReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreReaderMockRecorder
+}
+
+// MockStoreReaderMockRecorder is the mock recorder for MockStoreReader.
+type MockStoreReaderMockRecorder struct {
+	mock *MockStoreReader
+	stub bool
+}
+
+// NewMockStoreReader creates a new mock instance.
+func NewMockStoreReader(ctrl *gomock.Controller) *MockStoreReader {
+	mock := &MockStoreReader{ctrl: ctrl}
+	mock.recorder = &MockStoreReaderMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoreReader) EXPECT() *MockStoreReaderMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockStoreReader) STUB() *MockStoreReaderMockRecorder {
+	return &MockStoreReaderMockRecorder{mock: m, stub: true}
+}
+
+// Get mocks base method.
+func (m *MockStoreReader) Get(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreReaderMockRecorder) Get(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStoreReader)(nil).Get), key)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStoreReader)(nil).Get), key)
+}
+
+// MockStoreWriter is a mock of StoreWriter interface.
+type MockStoreWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreWriterMockRecorder
+}
+
+// MockStoreWriterMockRecorder is the mock recorder for MockStoreWriter.
+type MockStoreWriterMockRecorder struct {
+	mock *MockStoreWriter
+	stub bool
+}
+
+// NewMockStoreWriter creates a new mock instance.
+func NewMockStoreWriter(ctrl *gomock.Controller) *MockStoreWriter {
+	mock := &MockStoreWriter{ctrl: ctrl}
+	mock.recorder = &MockStoreWriterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoreWriter) EXPECT() *MockStoreWriterMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockStoreWriter) STUB() *MockStoreWriterMockRecorder {
+	return &MockStoreWriterMockRecorder{mock: m, stub: true}
+}
+
+// Put mocks base method.
+func (m *MockStoreWriter) Put(key string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStoreWriterMockRecorder) Put(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStoreWriter)(nil).Put), key, value)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStoreWriter)(nil).Put), key, value)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+	stub bool
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockNotifier) STUB() *MockNotifierMockRecorder {
+	return &MockNotifierMockRecorder{mock: m, stub: true}
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(event string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Notify", event)
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), event)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), event)
+}
@@ -0,0 +1,9 @@
+//go:build ignore
+// +build ignore
+
+package source_package
+
+// Ignored doesn't match the build constraints, so it isn't mocked.
+type Ignored interface {
+	Ignore()
+}
@@ -0,0 +1,7 @@
+package source_package
+
+import "context"
+
+type Reader interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+}
@@ -0,0 +1,12 @@
+package source_package
+
+//go:generate mockgen -source . -destination mock_source_package/mock.go
+
+import "io"
+
+// Store embeds Reader, which is declared in reader.go.
+type Store interface {
+	Reader
+	io.Closer
+	Put(key string, value []byte) error
+}
@@ -0,0 +1,37 @@
+package source_package_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/mock/mockgen/internal/tests/source_package"
+	"github.com/golang/mock/mockgen/internal/tests/source_package/mock_source_package"
+)
+
+var (
+	_ source_package.Reader = &mock_source_package.MockReader{}
+	_ source_package.Store  = &mock_source_package.MockStore{}
+)
+
+// Interfaces in tests aren't mocked either.
+type Tester interface {
+	Test()
+}
+
+func TestMockStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	s := mock_source_package.NewMockStore(ctrl)
+	s.EXPECT().Get(ctx, "k").Return([]byte("v"), nil)
+	s.EXPECT().Close().Return(nil)
+
+	var store source_package.Store = s
+	if v, err := store.Get(ctx, "k"); err != nil || string(v) != "v" {
+		t.Errorf("Get: got %q, %v; want %q, nil", v, err, "v")
+	}
+	if err := store.Close(); err != nil {
+		t.Errorf("Close: got %v, want nil", err)
+	}
+}
@@ -0,0 +1,64 @@
+package struct_mode
+
+//go:generate mockgen -destination mock/client_mock.go -struct github.com/golang/mock/mockgen/internal/tests/struct_mode.Client
+//go:generate mockgen -destination mock/store_mock.go -struct .Store -methods Get,Put
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrNotFound is returned for keys that aren't set.
+var ErrNotFound = errors.New("not found")
+
+// Client is a client of a key-value service.
+type Client struct {
+	addr string
+}
+
+// Get returns the value of key.
+func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
+	return nil, ErrNotFound
+}
+
+// Addr returns the address of the service.
+func (c Client) Addr() string {
+	return c.addr
+}
+
+func (c *Client) dial() error {
+	return nil
+}
+
+// Store is an in-memory key-value store.
+type Store struct {
+	sync.Mutex
+	values map[string][]byte
+}
+
+// Get returns the value of key.
+func (s *Store) Get(key string) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	v, ok := s.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return v, nil
+}
+
+// Put sets the value of key.
+func (s *Store) Put(key string, value []byte) {
+	s.Lock()
+	defer s.Unlock()
+	if s.values == nil {
+		s.values = make(map[string][]byte)
+	}
+	s.values[key] = value
+}
+
+// Keys returns the keys in the store.
+func (s *Store) Keys(prefixes ...string) []string {
+	return nil
+}
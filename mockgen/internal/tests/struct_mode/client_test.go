@@ -0,0 +1,41 @@
+package struct_mode_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/mock/mockgen/internal/tests/struct_mode"
+	mock_struct_mode "github.com/golang/mock/mockgen/internal/tests/struct_mode/mock"
+)
+
+// The structs implement the interfaces extracted from them, as do the mocks.
+var (
+	_ mock_struct_mode.Client = &struct_mode.Client{}
+	_ mock_struct_mode.Client = &mock_struct_mode.MockClient{}
+	_ mock_struct_mode.Store  = &struct_mode.Store{}
+	_ mock_struct_mode.Store  = &mock_struct_mode.MockStore{}
+)
+
+func TestMockStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var s mock_struct_mode.Store = mock_struct_mode.NewMockStore(ctrl)
+	s.(*mock_struct_mode.MockStore).EXPECT().Get("k").Return(nil, struct_mode.ErrNotFound)
+
+	if _, err := s.Get("k"); err != struct_mode.ErrNotFound {
+		t.Errorf("Get: got %v, want %v", err, struct_mode.ErrNotFound)
+	}
+}
+
+func TestMockClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	c := mock_struct_mode.NewMockClient(ctrl)
+	c.EXPECT().Get(ctx, "k").Return([]byte("v"), nil)
+
+	if v, err := c.Get(ctx, "k"); err != nil || string(v) != "v" {
+		t.Errorf("Get: got %q, %v; want %q, nil", v, err, "v")
+	}
+}
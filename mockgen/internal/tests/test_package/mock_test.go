@@ -19,12 +19,13 @@ type MockFinder struct {
 // MockFinderMockRecorder is the mock recorder for MockFinder.
 type MockFinderMockRecorder struct {
 	mock *MockFinder
+	stub bool
 }
 
 // NewMockFinder creates a new mock instance.
 func NewMockFinder(ctrl *gomock.Controller) *MockFinder {
 	mock := &MockFinder{ctrl: ctrl}
-	mock.recorder = &MockFinderMockRecorder{mock}
+	mock.recorder = &MockFinderMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockFinder) EXPECT() *MockFinderMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockFinder) STUB() *MockFinderMockRecorder {
+	return &MockFinderMockRecorder{mock: m, stub: true}
+}
+
 // Add mocks base method.
 func (m *MockFinder) Add(u User) {
 	m.ctrl.T.Helper()
@@ -42,6 +49,9 @@ func (m *MockFinder) Add(u User) {
 // Add indicates an expected call of Add.
 func (mr *MockFinderMockRecorder) Add(u interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockFinder)(nil).Add), u)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockFinder)(nil).Add), u)
 }
 
@@ -56,5 +66,8 @@ func (m *MockFinder) FindUser(name string) User {
 // FindUser indicates an expected call of FindUser.
 func (mr *MockFinderMockRecorder) FindUser(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "FindUser", reflect.TypeOf((*MockFinder)(nil).FindUser), name)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUser", reflect.TypeOf((*MockFinder)(nil).FindUser), name)
 }
@@ -19,12 +19,13 @@ type MockExample struct {
 // MockExampleMockRecorder is the mock recorder for MockExample.
 type MockExampleMockRecorder struct {
 	mock *MockExample
+	stub bool
 }
 
 // NewMockExample creates a new mock instance.
 func NewMockExample(ctrl *gomock.Controller) *MockExample {
 	mock := &MockExample{ctrl: ctrl}
-	mock.recorder = &MockExampleMockRecorder{mock}
+	mock.recorder = &MockExampleMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockExample) EXPECT() *MockExampleMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockExample) STUB() *MockExampleMockRecorder {
+	return &MockExampleMockRecorder{mock: m, stub: true}
+}
+
 // someMethod mocks base method.
 func (m *MockExample) someMethod(arg0 string) string {
 	m.ctrl.T.Helper()
@@ -44,5 +51,8 @@ func (m *MockExample) someMethod(arg0 string) string {
 // someMethod indicates an expected call of someMethod.
 func (mr *MockExampleMockRecorder) someMethod(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "someMethod", reflect.TypeOf((*MockExample)(nil).someMethod), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "someMethod", reflect.TypeOf((*MockExample)(nil).someMethod), arg0)
 }
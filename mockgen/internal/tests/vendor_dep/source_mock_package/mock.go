@@ -20,12 +20,13 @@ type MockVendorsDep struct {
 // MockVendorsDepMockRecorder is the mock recorder for MockVendorsDep.
 type MockVendorsDepMockRecorder struct {
 	mock *MockVendorsDep
+	stub bool
 }
 
 // NewMockVendorsDep creates a new mock instance.
 func NewMockVendorsDep(ctrl *gomock.Controller) *MockVendorsDep {
 	mock := &MockVendorsDep{ctrl: ctrl}
-	mock.recorder = &MockVendorsDepMockRecorder{mock}
+	mock.recorder = &MockVendorsDepMockRecorder{mock: mock}
 	return mock
 }
 
@@ -34,6 +35,12 @@ func (m *MockVendorsDep) EXPECT() *MockVendorsDepMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockVendorsDep) STUB() *MockVendorsDepMockRecorder {
+	return &MockVendorsDepMockRecorder{mock: m, stub: true}
+}
+
 // Foo mocks base method.
 func (m *MockVendorsDep) Foo() present.Elem {
 	m.ctrl.T.Helper()
@@ -45,5 +52,8 @@ func (m *MockVendorsDep) Foo() present.Elem {
 // Foo indicates an expected call of Foo.
 func (mr *MockVendorsDepMockRecorder) Foo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Foo", reflect.TypeOf((*MockVendorsDep)(nil).Foo))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Foo", reflect.TypeOf((*MockVendorsDep)(nil).Foo))
 }
@@ -19,12 +19,13 @@ type MockElem struct {
 // MockElemMockRecorder is the mock recorder for MockElem.
 type MockElemMockRecorder struct {
 	mock *MockElem
+	stub bool
 }
 
 // NewMockElem creates a new mock instance.
 func NewMockElem(ctrl *gomock.Controller) *MockElem {
 	mock := &MockElem{ctrl: ctrl}
-	mock.recorder = &MockElemMockRecorder{mock}
+	mock.recorder = &MockElemMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockElem) EXPECT() *MockElemMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockElem) STUB() *MockElemMockRecorder {
+	return &MockElemMockRecorder{mock: m, stub: true}
+}
+
 // TemplateName mocks base method.
 func (m *MockElem) TemplateName() string {
 	m.ctrl.T.Helper()
@@ -44,5 +51,8 @@ func (m *MockElem) TemplateName() string {
 // TemplateName indicates an expected call of TemplateName.
 func (mr *MockElemMockRecorder) TemplateName() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "TemplateName", reflect.TypeOf((*MockElem)(nil).TemplateName))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateName", reflect.TypeOf((*MockElem)(nil).TemplateName))
 }
@@ -40,6 +40,7 @@ import (
 	"github.com/golang/mock/mockgen/model"
 
 	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
 	toolsimports "golang.org/x/tools/imports"
 )
 
@@ -54,13 +55,18 @@ var (
 )
 
 var (
-	source          = flag.String("source", "", "(source mode) Input Go source file; enables source mode.")
+	source          = flag.String("source", "", "(source mode) Input Go source file, or directory or import path of a package; enables source mode.")
 	destination     = flag.String("destination", "", "Output file; defaults to stdout.")
 	mockNames       = flag.String("mock_names", "", "Comma-separated interfaceName=mockName pairs of explicit mock names to use. Mock names default to 'Mock'+ interfaceName suffix.")
 	packageOut      = flag.String("package", "", "Package of the generated code; defaults to the package of the input with a 'mock_' prefix.")
 	selfPackage     = flag.String("self_package", "", "The full package import path for the generated code. The purpose of this flag is to prevent import cycles in the generated code by trying to include its own package. This can happen if the mock's package is set to one of its inputs (usually the main one) and the output is stdio so mockgen cannot detect the final output package. Setting this flag will then tell mockgen which import to exclude.")
 	writePkgComment = flag.Bool("write_package_comment", true, "Writes package documentation comment (godoc) if true.")
 	copyrightFile   = flag.String("copyright_file", "", "Copyright file used to add copyright header")
+	deepStubs       = flag.Bool("deep_stubs", false, "Register the generated mocks with gomock, so that controllers created with gomock.WithDeepStubs can return them from unexpected calls.")
+	buildConstraint = flag.String("build_constraint", "", "If non-empty, added as //go:build <constraint> to the generated code.")
+	check           = flag.Bool("check", false, "Instead of writing the destination file, print its differences from the generated code, and fail if it is out of date.")
+	structType      = flag.String("struct", "", "(struct mode) A struct type, as import_path.Name, of which to generate an interface and a mock; enables struct mode.")
+	methods         = flag.String("methods", "", "(struct mode) Comma-separated list of the methods to include in the interface; defaults to all exported methods.")
 
 	debugParser = flag.Bool("debug_parser", false, "Print out parser results only.")
 	showVersion = flag.Bool("version", false, "Print version.")
@@ -75,11 +81,38 @@ func main() {
 		return
 	}
 
+	if *configFile != "" {
+		if err := configMode(*configFile); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *scan {
+		patterns := flag.Args()
+		if len(patterns) == 0 {
+			patterns = []string{"."}
+		}
+		if err := scanMode(patterns); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	var pkg *model.Package
 	var err error
 	var packageName string
 	if *source != "" {
 		pkg, err = sourceMode(*source)
+	} else if *structType != "" {
+		var structName string
+		packageName, structName = splitStructType(*structType)
+		packageName = resolvePackageName(packageName)
+		var methodNames []string
+		if *methods != "" {
+			methodNames = strings.Split(*methods, ",")
+		}
+		pkg, err = structMode(packageName, structName, methodNames)
 	} else {
 		if flag.NArg() != 2 {
 			usage()
@@ -87,17 +120,12 @@ func main() {
 		}
 		packageName = flag.Arg(0)
 		interfaces := strings.Split(flag.Arg(1), ",")
-		if packageName == "." {
-			dir, err := os.Getwd()
-			if err != nil {
-				log.Fatalf("Get current directory failed: %v", err)
-			}
-			packageName, err = packageNameOfDir(dir)
-			if err != nil {
-				log.Fatalf("Parse package name failed: %v", err)
-			}
+		packageName = resolvePackageName(packageName)
+		if *packageModeFlag {
+			pkg, err = packageMode(packageName, interfaces)
+		} else {
+			pkg, err = reflectMode(packageName, interfaces)
 		}
-		pkg, err = reflectMode(packageName, interfaces)
 	}
 	if err != nil {
 		log.Fatalf("Loading input failed: %v", err)
@@ -123,22 +151,18 @@ func main() {
 	// is output into an already existing package.
 	outputPackagePath := *selfPackage
 	if outputPackagePath == "" && *destination != "" {
-		dstPath, err := filepath.Abs(filepath.Dir(*destination))
-		if err == nil {
-			pkgPath, err := parsePackageImport(dstPath)
-			if err == nil {
-				outputPackagePath = pkgPath
-			} else {
-				log.Println("Unable to infer -self_package from destination file path:", err)
-			}
-		} else {
-			log.Println("Unable to determine destination file path:", err)
-		}
+		outputPackagePath = inferOutputPackagePath(*destination)
 	}
 
-	g := new(generator)
+	g := newGenerator()
 	if *source != "" {
 		g.filename = *source
+		if !isSourceFile(*source) {
+			g.filename = pkg.PkgPath
+		}
+	} else if *structType != "" {
+		g.srcPackage = packageName
+		g.srcStruct = pkg.Interfaces[0].Name
 	} else {
 		g.srcPackage = packageName
 		g.srcInterfaces = flag.Arg(1)
@@ -148,6 +172,21 @@ func main() {
 	if *mockNames != "" {
 		g.mockNames = parseMockNames(*mockNames)
 	}
+	if err := g.Generate(pkg, outputPackageName, outputPackagePath); err != nil {
+		log.Fatalf("Failed generating mock: %v", err)
+	}
+	if err := writeOutput(*destination, g.Output()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// newGenerator returns a generator configured by the flags that apply to all
+// modes.
+func newGenerator() *generator {
+	g := &generator{
+		deepStubs:       *deepStubs,
+		buildConstraint: *buildConstraint,
+	}
 	if *copyrightFile != "" {
 		header, err := ioutil.ReadFile(*copyrightFile)
 		if err != nil {
@@ -156,32 +195,62 @@ func main() {
 
 		g.copyrightHeader = string(header)
 	}
-	if err := g.Generate(pkg, outputPackageName, outputPackagePath); err != nil {
-		log.Fatalf("Failed generating mock: %v", err)
+	return g
+}
+
+// inferOutputPackagePath returns the import path of the package of the
+// destination file, or "" if it can't be determined.
+func inferOutputPackagePath(destination string) string {
+	dstPath, err := filepath.Abs(filepath.Dir(destination))
+	if err != nil {
+		log.Println("Unable to determine destination file path:", err)
+		return ""
 	}
-	output := g.Output()
-	dst := os.Stdout
-	if len(*destination) > 0 {
-		if err := os.MkdirAll(filepath.Dir(*destination), os.ModePerm); err != nil {
-			log.Fatalf("Unable to create directory: %v", err)
-		}
-		existing, err := ioutil.ReadFile(*destination)
-		if err != nil && !errors.Is(err, os.ErrNotExist) {
-			log.Fatalf("Failed reading pre-exiting destination file: %v", err)
+	pkgPath, err := parsePackageImport(dstPath)
+	if err != nil {
+		log.Println("Unable to infer -self_package from destination file path:", err)
+		return ""
+	}
+	return pkgPath
+}
+
+// writeOutput writes the generated code to the destination file, or to
+// stdout if destination is empty. A destination file that already has the
+// same content isn't written, to keep its modification time. In check mode,
+// the destination file isn't written: the differences from it are printed,
+// and an error is returned if there are any.
+func writeOutput(destination string, output []byte) error {
+	if destination == "" {
+		if *check {
+			return errors.New("-check requires -destination")
 		}
-		if len(existing) == len(output) && bytes.Compare(existing, output) == 0 {
-			return
+		if _, err := os.Stdout.Write(output); err != nil {
+			return fmt.Errorf("Failed writing to destination: %v", err)
 		}
-		f, err := os.Create(*destination)
-		if err != nil {
-			log.Fatalf("Failed opening destination file: %v", err)
+		return nil
+	}
+
+	existing, err := ioutil.ReadFile(destination)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("Failed reading pre-exiting destination file: %v", err)
+	}
+	if len(existing) == len(output) && bytes.Compare(existing, output) == 0 {
+		return nil
+	}
+	if *check {
+		if _, err := io.WriteString(os.Stdout, unifiedDiff(destination, destination, existing, output)); err != nil {
+			return fmt.Errorf("Failed writing diff: %v", err)
 		}
-		defer f.Close()
-		dst = f
+		return fmt.Errorf("%v is out of date", destination)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destination), os.ModePerm); err != nil {
+		return fmt.Errorf("Unable to create directory: %v", err)
 	}
-	if _, err := dst.Write(output); err != nil {
-		log.Fatalf("Failed writing to destination: %v", err)
+	if err := ioutil.WriteFile(destination, output, 0666); err != nil {
+		return fmt.Errorf("Failed writing to destination: %v", err)
 	}
+	return nil
 }
 
 func parseMockNames(names string) map[string]string {
@@ -196,18 +265,48 @@ func parseMockNames(names string) map[string]string {
 	return mocksMap
 }
 
+// resolvePackageName returns the import path of the package in the current
+// directory if packageName is ".", and packageName otherwise.
+func resolvePackageName(packageName string) string {
+	if packageName != "." {
+		return packageName
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatalf("Get current directory failed: %v", err)
+	}
+	packageName, err = packageNameOfDir(dir)
+	if err != nil {
+		log.Fatalf("Parse package name failed: %v", err)
+	}
+	return packageName
+}
+
+// splitStructType splits a struct type given as import_path.Name. The import
+// path may be omitted for the package in the current directory.
+func splitStructType(typ string) (importPath, name string) {
+	i := strings.LastIndex(typ, ".")
+	if i <= 0 {
+		return ".", typ[i+1:]
+	}
+	return typ[:i], typ[i+1:]
+}
+
 func usage() {
 	_, _ = io.WriteString(os.Stderr, usageText)
 	flag.PrintDefaults()
 }
 
-const usageText = `mockgen has two modes of operation: source and reflect.
+const usageText = `mockgen has six modes of operation: source, reflect, package, struct, scan and config.
 
-Source mode generates mock interfaces from a source file.
-It is enabled by using the -source flag. Other flags that
-may be useful in this mode are -imports and -aux_files.
+Source mode generates mock interfaces from a source file, or
+from the source files of a package given by its directory or
+import path. It is enabled by using the -source flag. Other
+flags that may be useful in this mode are -imports,
+-aux_files, -interfaces and -exclude_interfaces.
 Example:
 	mockgen -source=foo.go [other options]
+	mockgen -source=./foo [other options]
 
 Reflect mode generates mock interfaces by building a program
 that uses reflection to understand interfaces. It is enabled
@@ -216,6 +315,32 @@ comma-separated list of symbols.
 Example:
 	mockgen database/sql/driver Conn,Driver
 
+Package mode takes the same arguments as reflect mode, but
+loads the package by type checking it, without building a
+program. It is enabled by using the -package_mode flag.
+Example:
+	mockgen -package_mode database/sql/driver Conn,Driver
+
+Struct mode generates an interface of the exported methods of
+a struct, and a mock of that interface, by reflection like
+reflect mode. It is enabled by using the -struct flag. The
+-methods flag limits the interface to the listed methods.
+Example:
+	mockgen -struct net/http.Client -methods Do,Get
+
+Scan mode generates the mocks of the interfaces and func
+types annotated with a //mockgen:generate directive in the
+packages matching the non-flag arguments. It is enabled by
+using the -scan flag.
+Example:
+	mockgen -scan ./...
+
+Config mode generates the mocks listed in a YAML or JSON file,
+in source mode or package mode, in a single run. It is enabled
+by using the -config flag.
+Example:
+	mockgen -config mockgen.yaml
+
 `
 
 type generator struct {
@@ -225,11 +350,38 @@ type generator struct {
 	filename                  string            // may be empty
 	destination               string            // may be empty
 	srcPackage, srcInterfaces string            // may be empty
+	srcStruct                 string            // may be empty
 	copyrightHeader           string
+	deepStubs                 bool
+	buildConstraint           string // may be empty
+	srcImportPath             string // import path of the mocked interfaces' package
+	resultHelper              bool   // whether results are converted with gomock.Result, which needs Go 1.18
 
 	packageMap map[string]string // map from import path to package name
 }
 
+// plusBuildLine converts a //go:build expression to the arguments of the
+// equivalent // +build line understood by Go 1.16 and earlier. Expressions with
+// parentheses are not converted.
+func plusBuildLine(expr string) (string, bool) {
+	if strings.ContainsAny(expr, "()") {
+		return "", false
+	}
+	var terms []string
+	for _, term := range strings.Split(expr, "||") {
+		var factors []string
+		for _, factor := range strings.Split(term, "&&") {
+			factor = strings.Join(strings.Fields(factor), "")
+			if factor == "" || factor == "!" {
+				return "", false
+			}
+			factors = append(factors, factor)
+		}
+		terms = append(terms, strings.Join(factors, ","))
+	}
+	return strings.Join(terms, " "), true
+}
+
 func (g *generator) p(format string, args ...interface{}) {
 	fmt.Fprintf(&g.buf, g.indent+format+"\n", args...)
 }
@@ -273,6 +425,24 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 		outputPackagePath = ""
 	}
 
+	// The generated code may only use generics if the module it belongs to
+	// targets Go 1.18 or later.
+	outputDir := "."
+	if g.destination != "" {
+		outputDir = filepath.Dir(g.destination)
+	}
+	if v := moduleGoVersion(outputDir); v != "" && semver.Compare("v"+v, "v1.18") >= 0 {
+		g.resultHelper = true
+	}
+
+	if g.buildConstraint != "" {
+		g.p("//go:build %s", g.buildConstraint)
+		if line, ok := plusBuildLine(g.buildConstraint); ok {
+			g.p("// +build %s", line)
+		}
+		g.p("")
+	}
+
 	if g.copyrightHeader != "" {
 		lines := strings.Split(g.copyrightHeader, "\n")
 		for _, line := range lines {
@@ -284,6 +454,8 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 	g.p("// Code generated by MockGen. DO NOT EDIT.")
 	if g.filename != "" {
 		g.p("// Source: %v", g.filename)
+	} else if g.srcStruct != "" {
+		g.p("// Source: %v (struct: %v)", g.srcPackage, g.srcStruct)
 	} else {
 		g.p("// Source: %v (interfaces: %v)", g.srcPackage, g.srcInterfaces)
 	}
@@ -301,6 +473,28 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 			break
 		}
 	}
+	if len(pkg.Funcs) > 0 {
+		im["reflect"] = true
+	}
+
+	// Mock registration refers to the mocked interfaces and reflects on them.
+	// In reflect mode pkg.PkgPath is empty, but the source package is known.
+	g.srcImportPath = pkg.PkgPath
+	if g.srcImportPath == "" {
+		g.srcImportPath = g.srcPackage
+	}
+	for _, intf := range pkg.Interfaces {
+		if g.registersMock(intf, outputPackagePath) {
+			im[g.srcImportPath] = true
+			im["reflect"] = true
+		}
+	}
+	// Mocks of func types return functions of the mocked types.
+	for _, fn := range pkg.Funcs {
+		if g.exposesFuncType(fn, outputPackagePath) {
+			im[g.srcImportPath] = true
+		}
+	}
 
 	// Sort keys to make import alias generation predictable
 	sortedPaths := make([]string, len(im))
@@ -362,10 +556,18 @@ func (g *generator) Generate(pkg *model.Package, outputPkgName string, outputPac
 	g.p(")")
 
 	for _, intf := range pkg.Interfaces {
+		if g.srcStruct != "" {
+			g.GenerateInterface(intf, outputPackagePath)
+		}
 		if err := g.GenerateMockInterface(intf, outputPackagePath); err != nil {
 			return err
 		}
 	}
+	for _, fn := range pkg.Funcs {
+		if err := g.GenerateMockFunc(fn, outputPackagePath); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -405,6 +607,13 @@ func (g *generator) formattedTypeParams(it *model.Interface, pkgOverride string)
 }
 
 func (g *generator) GenerateMockInterface(intf *model.Interface, outputPackagePath string) error {
+	// The mock has a STUB method of its own.
+	for _, m := range intf.Methods {
+		if m.Name == "STUB" {
+			return fmt.Errorf("interface %v has a method named STUB, which mockgen reserves for the STUB method of mocks", intf.Name)
+		}
+	}
+
 	mockType := g.mockName(intf.Name)
 	longTp, shortTp := g.formattedTypeParams(intf, outputPackagePath)
 
@@ -422,6 +631,7 @@ func (g *generator) GenerateMockInterface(intf *model.Interface, outputPackagePa
 	g.p("type %vMockRecorder%v struct {", mockType, longTp)
 	g.in()
 	g.p("mock *%v%v", mockType, shortTp)
+	g.p("stub bool")
 	g.out()
 	g.p("}")
 	g.p("")
@@ -430,7 +640,7 @@ func (g *generator) GenerateMockInterface(intf *model.Interface, outputPackagePa
 	g.p("func New%v%v(ctrl *gomock.Controller) *%v%v {", mockType, longTp, mockType, shortTp)
 	g.in()
 	g.p("mock := &%v%v{ctrl: ctrl}", mockType, shortTp)
-	g.p("mock.recorder = &%vMockRecorder%v{mock}", mockType, shortTp)
+	g.p("mock.recorder = &%vMockRecorder%v{mock: mock}", mockType, shortTp)
 	g.p("return mock")
 	g.out()
 	g.p("}")
@@ -443,12 +653,154 @@ func (g *generator) GenerateMockInterface(intf *model.Interface, outputPackagePa
 	g.p("return m.recorder")
 	g.out()
 	g.p("}")
+	g.p("")
+
+	g.p("// STUB returns an object that allows the caller to define default behavior")
+	g.p("// that is used when no expected call matches, and is not verified.")
+	g.p("func (m *%v%v) STUB() *%vMockRecorder%v {", mockType, shortTp, mockType, shortTp)
+	g.in()
+	g.p("return &%vMockRecorder%v{mock: m, stub: true}", mockType, shortTp)
+	g.out()
+	g.p("}")
+
+	if g.registersMock(intf, outputPackagePath) {
+		intfType := &model.NamedType{Package: g.srcImportPath, Type: intf.Name}
+		g.p("")
+		g.p("func init() {")
+		g.in()
+		g.p("gomock.RegisterMockConstructor(reflect.TypeOf((*%v)(nil)).Elem(), func(ctrl *gomock.Controller) interface{} {", intfType.String(g.packageMap, outputPackagePath))
+		g.in()
+		g.p("return New%v(ctrl)", mockType)
+		g.out()
+		g.p("})")
+		g.out()
+		g.p("}")
+	}
 
 	g.GenerateMockMethods(mockType, intf, outputPackagePath, shortTp)
 
 	return nil
 }
 
+// GenerateInterface generates the declaration of an interface extracted from
+// a struct.
+func (g *generator) GenerateInterface(intf *model.Interface, pkgOverride string) {
+	g.p("")
+	g.p("// %v is an interface of the methods of %v.%v.", intf.Name, path.Base(g.srcImportPath), g.srcStruct)
+	g.p("type %v interface {", intf.Name)
+	g.in()
+	sort.Sort(byMethodName(intf.Methods))
+	for _, m := range intf.Methods {
+		rets := make([]string, len(m.Out))
+		for i, p := range m.Out {
+			rets[i] = p.Type.String(g.packageMap, pkgOverride)
+		}
+		retString := strings.Join(rets, ", ")
+		if len(rets) > 1 {
+			retString = "(" + retString + ")"
+		}
+		if retString != "" {
+			retString = " " + retString
+		}
+		g.p("%v(%v)%v", m.Name, strings.Join(g.getArgTypes(m, pkgOverride), ", "), retString)
+	}
+	g.out()
+	g.p("}")
+}
+
+// registersMock reports whether the mock of intf is registered with gomock
+// for deep stubs. Generic interfaces can't be, since they have no single type,
+// nor can unexported interfaces of other packages. Interfaces extracted from
+// structs aren't the results of any methods.
+func (g *generator) registersMock(intf *model.Interface, outputPackagePath string) bool {
+	if !g.deepStubs || g.srcImportPath == "" || g.srcStruct != "" || len(intf.TypeParams) > 0 {
+		return false
+	}
+	return token.IsExported(intf.Name) || outputPackagePath == g.srcImportPath
+}
+
+// GenerateMockFunc generates a mock of a named func type. Calls of the
+// function returned by the mock's Fn method are calls of its Call method.
+func (g *generator) GenerateMockFunc(fn *model.Func, outputPackagePath string) error {
+	mockType := g.mockName(fn.Name)
+
+	g.p("")
+	g.p("// %v is a mock of %v func type.", mockType, fn.Name)
+	g.p("type %v struct {", mockType)
+	g.in()
+	g.p("ctrl     *gomock.Controller")
+	g.p("recorder *%vMockRecorder", mockType)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// %vMockRecorder is the mock recorder for %v.", mockType, mockType)
+	g.p("type %vMockRecorder struct {", mockType)
+	g.in()
+	g.p("mock *%v", mockType)
+	g.p("stub bool")
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// New%v creates a new mock instance.", mockType)
+	g.p("func New%v(ctrl *gomock.Controller) *%v {", mockType, mockType)
+	g.in()
+	g.p("mock := &%v{ctrl: ctrl}", mockType)
+	g.p("mock.recorder = &%vMockRecorder{mock: mock}", mockType)
+	g.p("return mock")
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// EXPECT returns an object that allows the caller to indicate expected use.")
+	g.p("func (m *%v) EXPECT() *%vMockRecorder {", mockType, mockType)
+	g.in()
+	g.p("return m.recorder")
+	g.out()
+	g.p("}")
+	g.p("")
+
+	g.p("// STUB returns an object that allows the caller to define default behavior")
+	g.p("// that is used when no expected call matches, and is not verified.")
+	g.p("func (m *%v) STUB() *%vMockRecorder {", mockType, mockType)
+	g.in()
+	g.p("return &%vMockRecorder{mock: m, stub: true}", mockType)
+	g.out()
+	g.p("}")
+	g.p("")
+
+	// An unexported func type of another package can't be named, but the
+	// function can still be assigned to it.
+	var fnType model.Type = fn.Type
+	if g.exposesFuncType(fn, outputPackagePath) {
+		fnType = &model.NamedType{Package: g.srcImportPath, Type: fn.Name}
+	}
+	g.p("// Fn returns a function that calls the mock.")
+	g.p("func (m *%v) Fn() %v {", mockType, fnType.String(g.packageMap, outputPackagePath))
+	g.in()
+	g.p("return m.Call")
+	g.out()
+	g.p("}")
+
+	m := fn.Method()
+	g.p("")
+	_ = g.GenerateMockMethod(mockType, m, outputPackagePath, "")
+	g.p("")
+	_ = g.GenerateMockRecorderMethod(mockType, m, "")
+
+	return nil
+}
+
+// exposesFuncType reports whether the Fn method of the mock of fn returns the
+// named func type rather than its underlying type.
+func (g *generator) exposesFuncType(fn *model.Func, outputPackagePath string) bool {
+	if g.srcImportPath == "" {
+		return false
+	}
+	return token.IsExported(fn.Name) || outputPackagePath == g.srcImportPath
+}
+
 type byMethodName []*model.Method
 
 func (b byMethodName) Len() int           { return len(b) }
@@ -531,11 +883,16 @@ func (g *generator) GenerateMockMethod(mockType string, m *model.Method, pkgOver
 
 		// Go does not allow "naked" type assertions on nil values, so we use the two-value form here.
 		// The value of that is either (x.(T), true) or (Z, false), where Z is the zero value for T.
-		// Happily, this coincides with the semantics we want here.
+		// Happily, this coincides with the semantics we want here. gomock.Result
+		// also fails the test on results of other types.
 		retNames := make([]string, len(rets))
 		for i, t := range rets {
 			retNames[i] = ia.allocateIdentifier(fmt.Sprintf("ret%d", i))
-			g.p("%s, _ := %s[%d].(%s)", retNames[i], idRet, i, t)
+			if g.resultHelper {
+				g.p("%s := gomock.Result[%s](%s.ctrl, %s, %q, %s, %d)", retNames[i], t, idRecv, idRecv, m.Name, idRet, i)
+			} else {
+				g.p("%s, _ := %s[%d].(%s)", retNames[i], idRet, i, t)
+			}
 		}
 		g.p("return " + strings.Join(retNames, ", "))
 	}
@@ -592,6 +949,11 @@ func (g *generator) GenerateMockRecorderMethod(mockType string, m *model.Method,
 			callArgs = ", " + idVarArgs + "..."
 		}
 	}
+	g.p("if %s.stub {", idRecv)
+	g.in()
+	g.p(`return %s.mock.ctrl.RecordStubWithMethodType(%s.mock, "%s", reflect.TypeOf((*%s%s)(nil).%s)%s)`, idRecv, idRecv, m.Name, mockType, shortTp, m.Name, callArgs)
+	g.out()
+	g.p("}")
 	g.p(`return %s.mock.ctrl.RecordCallWithMethodType(%s.mock, "%s", reflect.TypeOf((*%s%s)(nil).%s)%s)`, idRecv, idRecv, m.Name, mockType, shortTp, m.Name, callArgs)
 
 	g.out()
@@ -693,6 +1055,29 @@ func printVersion() {
 	}
 }
 
+// moduleGoVersion returns the Go version declared by the go.mod file of the
+// module dir belongs to, or "" if there is none.
+func moduleGoVersion(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			f, err := modfile.ParseLax("go.mod", data, nil)
+			if err != nil || f.Go == nil {
+				return ""
+			}
+			return f.Go.Version
+		}
+		if dir == filepath.Dir(dir) {
+			return ""
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
 // parseImportPackage get package import path via source file
 // an alternative implementation is to use:
 // cfg := &packages.Config{Mode: packages.NeedName, Tests: true, Dir: srcDir}
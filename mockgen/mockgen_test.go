@@ -261,6 +261,17 @@ func TestGenerateMockInterface_Helper(t *testing.T) {
 	}
 }
 
+func TestGenerateMockInterface_STUB(t *testing.T) {
+	g := generator{}
+	intf := &model.Interface{Name: "Somename"}
+	intf.AddMethod(&model.Method{Name: "STUB"})
+
+	err := g.GenerateMockInterface(intf, "somepackage")
+	if err == nil || !strings.Contains(err.Error(), "method named STUB") {
+		t.Errorf("Expected an error for the STUB method but got %v", err)
+	}
+}
+
 func findMethod(t *testing.T, identifier, methodName string, lines []string) int {
 	t.Helper()
 	r := regexp.MustCompile(fmt.Sprintf(`func\s+\(.+%s\)\s*%s`, identifier, methodName))
@@ -368,6 +379,117 @@ func Test_createPackageMap(t *testing.T) {
 	}
 }
 
+func TestPlusBuildLine(t *testing.T) {
+	tests := []struct {
+		expr     string
+		wantLine string
+		wantOK   bool
+	}{
+		{"go1.16", "go1.16", true},
+		{"linux && !cgo", "linux,!cgo", true},
+		{"linux || darwin && amd64", "linux darwin,amd64", true},
+		{"! windows", "!windows", true},
+		{"(linux || darwin) && amd64", "", false},
+		{"linux &&", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			gotLine, gotOK := plusBuildLine(tt.expr)
+			if gotLine != tt.wantLine || gotOK != tt.wantOK {
+				t.Errorf("plusBuildLine(%q) = %q, %v; want %q, %v", tt.expr, gotLine, gotOK, tt.wantLine, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSplitStructType(t *testing.T) {
+	tests := []struct {
+		typ            string
+		wantImportPath string
+		wantName       string
+	}{
+		{"net/http.Client", "net/http", "Client"},
+		{"gopkg.in/yaml.v2.Decoder", "gopkg.in/yaml.v2", "Decoder"},
+		{".Client", ".", "Client"},
+		{"Client", ".", "Client"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			gotImportPath, gotName := splitStructType(tt.typ)
+			if gotImportPath != tt.wantImportPath || gotName != tt.wantName {
+				t.Errorf("splitStructType(%q) = %q, %q; want %q, %q", tt.typ, gotImportPath, gotName, tt.wantImportPath, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestGenerate_ResultHelper(t *testing.T) {
+	for _, test := range []struct {
+		goVersion string
+		want      string
+	}{
+		{goVersion: "1.15", want: "ret0, _ := ret[0].(int)"},
+		{goVersion: "1.18", want: `ret0 := gomock.Result[int](m.ctrl, m, "MethodA", ret, 0)`},
+		{goVersion: "1.21.0", want: `ret0 := gomock.Result[int](m.ctrl, m, "MethodA", ret, 0)`},
+	} {
+		t.Run(test.goVersion, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "result")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			goMod := fmt.Sprintf("module example.com/m\n\ngo %v\n", test.goVersion)
+			if err := ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			intf := &model.Interface{Name: "Somename"}
+			intf.AddMethod(&model.Method{Name: "MethodA", Out: []*model.Parameter{{Type: model.PredeclaredType("int")}}})
+			pkg := &model.Package{Name: "somepackage", Interfaces: []*model.Interface{intf}}
+
+			g := newGenerator()
+			g.destination = filepath.Join(dir, "mocks", "mock.go")
+			if err := g.Generate(pkg, "mocks", "example.com/m/mocks"); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(g.buf.String(), test.want) {
+				t.Errorf("the generated code doesn't contain %q:\n%s", test.want, g.buf.String())
+			}
+		})
+	}
+}
+
+func TestWriteOutput_Check(t *testing.T) {
+	*check = true
+	defer func() { *check = false }()
+
+	dir, err := ioutil.TempDir("", "check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	destination := filepath.Join(dir, "mock.go")
+	if err := ioutil.WriteFile(destination, []byte("package mock\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeOutput(destination, []byte("package mock\n")); err != nil {
+		t.Errorf("writeOutput of the same content: %v", err)
+	}
+	if err := writeOutput(destination, []byte("package mock_foo\n")); err == nil {
+		t.Error("writeOutput of different content: expected an error")
+	}
+	if err := writeOutput(filepath.Join(dir, "missing.go"), []byte("package mock\n")); err == nil {
+		t.Error("writeOutput to a missing file: expected an error")
+	}
+	if content, err := ioutil.ReadFile(destination); err != nil || string(content) != "package mock\n" {
+		t.Errorf("the destination was modified: %q, %v", content, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "missing.go")); !os.IsNotExist(err) {
+		t.Errorf("the missing file was written: %v", err)
+	}
+}
+
 func TestParsePackageImport_FallbackGoPath(t *testing.T) {
 	goPath, err := ioutil.TempDir("", "gopath")
 	if err != nil {
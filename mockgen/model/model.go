@@ -31,15 +31,19 @@ type Package struct {
 	Name       string
 	PkgPath    string
 	Interfaces []*Interface
+	Funcs      []*Func
 	DotImports []string
 }
 
-// Print writes the package name and its exported interfaces.
+// Print writes the package name and its exported interfaces and func types.
 func (pkg *Package) Print(w io.Writer) {
 	_, _ = fmt.Fprintf(w, "package %s\n", pkg.Name)
 	for _, intf := range pkg.Interfaces {
 		intf.Print(w)
 	}
+	for _, fn := range pkg.Funcs {
+		fn.Print(w)
+	}
 }
 
 // Imports returns the imports needed by the Package as a set of import paths.
@@ -51,6 +55,9 @@ func (pkg *Package) Imports() map[string]bool {
 			tp.Type.addImports(im)
 		}
 	}
+	for _, fn := range pkg.Funcs {
+		fn.Type.addImports(im)
+	}
 	return im
 }
 
@@ -85,6 +92,28 @@ func (intf *Interface) AddMethod(m *Method) {
 	intf.Methods = append(intf.Methods, m)
 }
 
+// Func is a named func type.
+type Func struct {
+	Name string
+	Type *FuncType
+}
+
+// Print writes the func type name and its signature.
+func (fn *Func) Print(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "func %s\n", fn.Name)
+	fn.Method().Print(w)
+}
+
+// Method returns the method that is called by the func type's mock.
+func (fn *Func) Method() *Method {
+	return &Method{
+		Name:     "Call",
+		In:       fn.Type.In,
+		Out:      fn.Type.Out,
+		Variadic: fn.Type.Variadic,
+	}
+}
+
 // Method is a single method of an interface.
 type Method struct {
 	Name     string
@@ -362,6 +391,53 @@ func InterfaceFromInterfaceType(it reflect.Type) (*Interface, error) {
 	return intf, nil
 }
 
+// InterfaceFromStructType returns a pointer to an interface of the exported
+// method set of a pointer to the given reflection struct type.
+func InterfaceFromStructType(st reflect.Type) (*Interface, error) {
+	if st.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%v is not a struct", st)
+	}
+	pt := reflect.PtrTo(st)
+	intf := &Interface{}
+
+	for i := 0; i < pt.NumMethod(); i++ {
+		mt := pt.Method(i)
+		m := &Method{
+			Name: mt.Name,
+		}
+
+		var err error
+		m.In, m.Variadic, m.Out, err = funcArgsFromType(mt.Type)
+		if err != nil {
+			return nil, err
+		}
+		m.In = m.In[1:] // the receiver
+
+		intf.AddMethod(m)
+	}
+
+	return intf, nil
+}
+
+// FuncFromFuncType returns a pointer to a func for the given reflection
+// func type.
+func FuncFromFuncType(ft reflect.Type) (*Func, error) {
+	if ft.Kind() != reflect.Func {
+		return nil, fmt.Errorf("%v is not a func", ft)
+	}
+	in, variadic, out, err := funcArgsFromType(ft)
+	if err != nil {
+		return nil, err
+	}
+	return &Func{
+		Type: &FuncType{
+			In:       in,
+			Out:      out,
+			Variadic: variadic,
+		},
+	}, nil
+}
+
 // t's Kind must be a reflect.Func.
 func funcArgsFromType(t reflect.Type) (in []*Parameter, variadic *Parameter, out []*Parameter, err error) {
 	nin := t.NumIn()
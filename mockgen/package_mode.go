@@ -0,0 +1,309 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// This file contains the model construction by type checking.
+
+import (
+	"flag"
+	"fmt"
+	"go/build"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/golang/mock/mockgen/model"
+
+	"golang.org/x/tools/go/packages"
+)
+
+var packageModeFlag = flag.Bool("package_mode", false, "(package mode) Load the package given by the non-flag arguments with go/packages instead of reflection; enables package mode.")
+
+// packageMode generates mocks by type checking the package with go/packages.
+func packageMode(importPath string, symbols []string) (*model.Package, error) {
+	// The packages are type checked here rather than by go/packages, since the
+	// versions of it that support Go 1.15 don't know the type sizes of later Go
+	// releases.
+	fset := token.NewFileSet()
+	pkgs, err := loadPackages(fset, *buildFlags, importPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading package %v: %v", importPath, err)
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("loading package %v: got %d packages, want 1", importPath, len(pkgs))
+	}
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("loading package %v: %v", importPath, pkg.Errors[0])
+	}
+
+	tpkg, err := newPackageChecker(fset).check(pkg)
+	if err != nil {
+		return nil, fmt.Errorf("type checking package %v: %v", importPath, err)
+	}
+
+	p := &packageModeParser{}
+	return p.parsePackage(tpkg, symbols)
+}
+
+// loadPackages loads the packages matching patterns, and their dependencies,
+// with the syntax needed to type check them with a packageChecker.
+func loadPackages(fset *token.FileSet, buildFlags string, patterns ...string) ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedSyntax,
+		Fset: fset,
+	}
+	if buildFlags != "" {
+		cfg.BuildFlags = strings.Split(buildFlags, " ")
+	}
+	return packages.Load(cfg, patterns...)
+}
+
+// packageChecker type checks packages loaded by go/packages, and their
+// dependencies. Function bodies are skipped, since only the declarations are
+// needed to generate mocks.
+type packageChecker struct {
+	fset    *token.FileSet
+	sizes   types.Sizes
+	checked map[string]*types.Package // by package ID
+}
+
+func newPackageChecker(fset *token.FileSet) *packageChecker {
+	return &packageChecker{
+		fset:    fset,
+		sizes:   types.SizesFor("gc", build.Default.GOARCH),
+		checked: make(map[string]*types.Package),
+	}
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+// check type checks pkg. Only errors in pkg itself are reported; packages
+// with errors in their dependencies can often still be mocked.
+func (c *packageChecker) check(pkg *packages.Package) (*types.Package, error) {
+	if pkg.PkgPath == "unsafe" {
+		return types.Unsafe, nil
+	}
+	if tpkg, ok := c.checked[pkg.ID]; ok {
+		return tpkg, nil
+	}
+
+	var firstErr error
+	cfg := &types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			ipkg, ok := pkg.Imports[path]
+			if !ok {
+				return nil, fmt.Errorf("no package %v imported by %v", path, pkg.PkgPath)
+			}
+			tpkg, _ := c.check(ipkg)
+			return tpkg, nil
+		}),
+		IgnoreFuncBodies: true,
+		Error: func(err error) {
+			if firstErr == nil {
+				firstErr = err
+			}
+		},
+		Sizes: c.sizes,
+	}
+	tpkg, _ := cfg.Check(pkg.PkgPath, c.fset, pkg.Syntax, nil)
+	c.checked[pkg.ID] = tpkg
+	return tpkg, firstErr
+}
+
+// packageModeParser converts go/types objects to the model.
+type packageModeParser struct{}
+
+// parsePackage returns the package model of the interfaces and func types
+// named by symbols in pkg.
+func (p *packageModeParser) parsePackage(pkg *types.Package, symbols []string) (*model.Package, error) {
+	mp := &model.Package{
+		Name:    pkg.Name(),
+		PkgPath: pkg.Path(),
+	}
+	for _, sym := range symbols {
+		obj, ok := pkg.Scope().Lookup(sym).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("%v.%v is not a type", pkg.Path(), sym)
+		}
+
+		typ := unalias(obj.Type())
+		switch t := typ.Underlying().(type) {
+		case *types.Interface:
+			intf, err := p.parseInterface(sym, typ, t)
+			if err != nil {
+				return nil, fmt.Errorf("%v.%v: %v", pkg.Path(), sym, err)
+			}
+			mp.Interfaces = append(mp.Interfaces, intf)
+		case *types.Signature:
+			ft, err := p.parseFunc(t)
+			if err != nil {
+				return nil, fmt.Errorf("%v.%v: %v", pkg.Path(), sym, err)
+			}
+			mp.Funcs = append(mp.Funcs, &model.Func{Name: sym, Type: ft})
+		default:
+			return nil, fmt.Errorf("%v.%v is neither an interface nor a func type", pkg.Path(), sym)
+		}
+	}
+	return mp, nil
+}
+
+// parseInterface returns the model of the interface it, which is the
+// underlying type of typ. Its methods include those of embedded interfaces.
+func (p *packageModeParser) parseInterface(name string, typ types.Type, it *types.Interface) (*model.Interface, error) {
+	intf := &model.Interface{Name: name}
+
+	var err error
+	if named, ok := typ.(*types.Named); ok {
+		intf.TypeParams, err = p.parseTypeParams(named)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	it = it.Complete()
+	for i := 0; i < it.NumMethods(); i++ {
+		fn := it.Method(i)
+		ft, err := p.parseFunc(fn.Type().(*types.Signature))
+		if err != nil {
+			return nil, fmt.Errorf("method %v: %v", fn.Name(), err)
+		}
+		intf.AddMethod(&model.Method{
+			Name:     fn.Name(),
+			In:       ft.In,
+			Out:      ft.Out,
+			Variadic: ft.Variadic,
+		})
+	}
+	return intf, nil
+}
+
+func (p *packageModeParser) parseFunc(sig *types.Signature) (*model.FuncType, error) {
+	in, err := p.parseTuple(sig.Params())
+	if err != nil {
+		return nil, err
+	}
+	out, err := p.parseTuple(sig.Results())
+	if err != nil {
+		return nil, err
+	}
+
+	var variadic *model.Parameter
+	if sig.Variadic() {
+		variadic = in[len(in)-1]
+		variadic.Type = variadic.Type.(*model.ArrayType).Type
+		in = in[:len(in)-1]
+	}
+	return &model.FuncType{In: in, Out: out, Variadic: variadic}, nil
+}
+
+func (p *packageModeParser) parseTuple(tuple *types.Tuple) ([]*model.Parameter, error) {
+	var ps []*model.Parameter
+	for i := 0; i < tuple.Len(); i++ {
+		v := tuple.At(i)
+		t, err := p.parseType(v.Type())
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, &model.Parameter{Name: v.Name(), Type: t})
+	}
+	return ps, nil
+}
+
+func (p *packageModeParser) parseType(t types.Type) (model.Type, error) {
+	t = unalias(t)
+	if mt, ok, err := p.parseGenericType(t); ok {
+		return mt, err
+	}
+
+	switch t := t.(type) {
+	case *types.Array:
+		elem, err := p.parseType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &model.ArrayType{Len: int(t.Len()), Type: elem}, nil
+	case *types.Basic:
+		if t.Kind() == types.UnsafePointer {
+			return &model.NamedType{Package: "unsafe", Type: "Pointer"}, nil
+		}
+		return model.PredeclaredType(t.Name()), nil
+	case *types.Chan:
+		elem, err := p.parseType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		var dir model.ChanDir
+		switch t.Dir() {
+		case types.RecvOnly:
+			dir = model.RecvDir
+		case types.SendOnly:
+			dir = model.SendDir
+		}
+		return &model.ChanType{Dir: dir, Type: elem}, nil
+	case *types.Interface:
+		if t.Empty() {
+			return model.PredeclaredType("interface{}"), nil
+		}
+		return nil, fmt.Errorf("can't handle non-empty unnamed interface type %v", t)
+	case *types.Map:
+		key, err := p.parseType(t.Key())
+		if err != nil {
+			return nil, err
+		}
+		value, err := p.parseType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &model.MapType{Key: key, Value: value}, nil
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			// error and comparable
+			return model.PredeclaredType(obj.Name()), nil
+		}
+		typeArgs, err := p.parseTypeArgs(t)
+		if err != nil {
+			return nil, err
+		}
+		return &model.NamedType{
+			Package:    obj.Pkg().Path(),
+			Type:       obj.Name(),
+			TypeParams: typeArgs,
+		}, nil
+	case *types.Pointer:
+		elem, err := p.parseType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &model.PointerType{Type: elem}, nil
+	case *types.Signature:
+		return p.parseFunc(t)
+	case *types.Slice:
+		elem, err := p.parseType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &model.ArrayType{Len: -1, Type: elem}, nil
+	case *types.Struct:
+		if t.NumFields() == 0 {
+			return model.PredeclaredType("struct{}"), nil
+		}
+		return nil, fmt.Errorf("can't handle non-empty unnamed struct type %v", t)
+	}
+	return nil, fmt.Errorf("can't yet turn %v (%T) into a model.Type", t, t)
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package main
+
+import (
+	"go/types"
+
+	"github.com/golang/mock/mockgen/model"
+)
+
+var anyType = types.Universe.Lookup("any").Type()
+
+func (p *packageModeParser) parseTypeParams(named *types.Named) ([]*model.Parameter, error) {
+	tps := named.TypeParams()
+	var ps []*model.Parameter
+	for i := 0; i < tps.Len(); i++ {
+		tp := tps.At(i)
+		t, err := p.parseType(tp.Constraint())
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, &model.Parameter{Name: tp.Obj().Name(), Type: t})
+	}
+	return ps, nil
+}
+
+func (p *packageModeParser) parseTypeArgs(named *types.Named) (*model.TypeParametersType, error) {
+	args := named.TypeArgs()
+	if args.Len() == 0 {
+		return nil, nil
+	}
+	tps := &model.TypeParametersType{}
+	for i := 0; i < args.Len(); i++ {
+		t, err := p.parseType(args.At(i))
+		if err != nil {
+			return nil, err
+		}
+		tps.TypeParameters = append(tps.TypeParameters, t)
+	}
+	return tps, nil
+}
+
+// parseGenericType parses type parameters and the any constraint. It
+// reports false for other types.
+func (p *packageModeParser) parseGenericType(t types.Type) (model.Type, bool, error) {
+	if isAnyAlias(t) {
+		return model.PredeclaredType("any"), true, nil
+	}
+	switch t := t.(type) {
+	case *types.TypeParam:
+		return model.PredeclaredType(t.Obj().Name()), true, nil
+	case *types.Interface:
+		if t == anyType {
+			return model.PredeclaredType("any"), true, nil
+		}
+	}
+	return nil, false, nil
+}
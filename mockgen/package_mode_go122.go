@@ -0,0 +1,34 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.22
+// +build go1.22
+
+package main
+
+import "go/types"
+
+// unalias returns the type that t is an alias of, or t if it isn't an alias.
+// The any alias is kept, so that constraints are written as in the source.
+func unalias(t types.Type) types.Type {
+	if isAnyAlias(t) {
+		return t
+	}
+	return types.Unalias(t)
+}
+
+func isAnyAlias(t types.Type) bool {
+	a, ok := t.(*types.Alias)
+	return ok && a.Obj().Pkg() == nil && a.Obj().Name() == "any"
+}
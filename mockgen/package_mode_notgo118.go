@@ -0,0 +1,36 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !go1.18
+// +build !go1.18
+
+package main
+
+import (
+	"go/types"
+
+	"github.com/golang/mock/mockgen/model"
+)
+
+func (p *packageModeParser) parseTypeParams(named *types.Named) ([]*model.Parameter, error) {
+	return nil, nil
+}
+
+func (p *packageModeParser) parseTypeArgs(named *types.Named) (*model.TypeParametersType, error) {
+	return nil, nil
+}
+
+func (p *packageModeParser) parseGenericType(t types.Type) (model.Type, bool, error) {
+	return nil, false, nil
+}
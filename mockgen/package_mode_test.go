@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPackageMode(t *testing.T) {
+	pkg, err := packageMode("github.com/golang/mock/mockgen/internal/tests/package_mode", []string{"Store", "closer", "Hook"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if pkg.Name != "package_mode" {
+		t.Errorf("Expected name to be %v but got %v", "package_mode", pkg.Name)
+	}
+	if len(pkg.Interfaces) != 2 || len(pkg.Funcs) != 1 {
+		t.Fatalf("Expected 2 interfaces and 1 func type but got %d and %d", len(pkg.Interfaces), len(pkg.Funcs))
+	}
+
+	var methods []string
+	for _, m := range pkg.Interfaces[0].Methods {
+		methods = append(methods, m.Name)
+	}
+	if got, want := strings.Join(methods, ","), "Close,Get,Put"; got != want {
+		t.Errorf("Expected methods of Store to be %v but got %v", want, got)
+	}
+	if got, want := pkg.Interfaces[1].Methods[0].Name, "close"; got != want {
+		t.Errorf("Expected method of closer to be %v but got %v", want, got)
+	}
+}
+
+func TestPackageMode_Errors(t *testing.T) {
+	tests := []struct {
+		symbol  string
+		wantErr string
+	}{
+		{"Missing", "is not a type"},
+		{"Option", "is neither an interface nor a func type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			_, err := packageMode("github.com/golang/mock/mockgen/internal/tests/package_mode", []string{tt.symbol})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q but got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
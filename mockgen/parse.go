@@ -28,8 +28,10 @@ import (
 	"go/types"
 	"io/ioutil"
 	"log"
+	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -39,64 +41,267 @@ import (
 var (
 	imports  = flag.String("imports", "", "(source mode) Comma-separated name=path pairs of explicit imports to use.")
 	auxFiles = flag.String("aux_files", "", "(source mode) Comma-separated pkg=path pairs of auxiliary Go source files.")
+
+	interfaces        = flag.String("interfaces", "", "(source mode) Comma-separated list of the interfaces and func types to mock, which may be glob patterns; defaults to all interfaces and no func types.")
+	excludeInterfaces = flag.String("exclude_interfaces", "", "(source mode) Comma-separated list of the interfaces and func types not to mock, which may be glob patterns.")
 )
 
-// sourceMode generates mocks via source file.
+// sourceMode generates mocks via source file, or via the source files of the
+// package in a directory or of an import path.
 func sourceMode(source string) (*model.Package, error) {
-	srcDir, err := filepath.Abs(filepath.Dir(source))
+	filter, err := newTypeFilter(splitList(*interfaces), splitList(*excludeInterfaces))
 	if err != nil {
-		return nil, fmt.Errorf("failed getting source directory: %v", err)
+		return nil, err
+	}
+	if isSourceFile(source) {
+		srcDir, err := filepath.Abs(filepath.Dir(source))
+		if err != nil {
+			return nil, fmt.Errorf("failed getting source directory: %v", err)
+		}
+		return newFileParser(srcDir).parseSource(source, *imports, *auxFiles, filter)
 	}
 
-	packageImport, err := parsePackageImport(srcDir)
+	bpkg, err := importSourcePackage(source)
 	if err != nil {
 		return nil, err
 	}
+	return newFileParser(bpkg.Dir).parseSourcePackage(bpkg, *imports, *auxFiles, filter)
+}
+
+// isSourceFile reports whether the source of source mode is a Go file, rather
+// than a package.
+func isSourceFile(source string) bool {
+	return strings.HasSuffix(source, ".go")
+}
+
+// importSourcePackage finds the package of the source of source mode, which
+// is either a directory or an import path.
+func importSourcePackage(source string) (*build.Package, error) {
+	if fi, err := os.Stat(source); err == nil && fi.IsDir() {
+		dir, err := filepath.Abs(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed getting source directory: %v", err)
+		}
+		bpkg, err := build.ImportDir(dir, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed loading source package %v: %v", source, err)
+		}
+		return bpkg, nil
+	}
 
-	fs := token.NewFileSet()
-	file, err := parser.ParseFile(fs, source, nil, 0)
+	wd, err := os.Getwd()
 	if err != nil {
-		return nil, fmt.Errorf("failed parsing source file %v: %v", source, err)
+		return nil, fmt.Errorf("failed getting current directory: %v", err)
 	}
+	bpkg, err := build.Import(source, wd, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading source package %v: %v", source, err)
+	}
+	return bpkg, nil
+}
 
-	p := &fileParser{
-		fileSet:            fs,
+// newFileParser returns a parser of the source files in srcDir.
+func newFileParser(srcDir string) *fileParser {
+	return &fileParser{
+		fileSet:            token.NewFileSet(),
 		imports:            make(map[string]importedPackage),
 		importedInterfaces: newInterfaceCache(),
 		auxInterfaces:      newInterfaceCache(),
 		srcDir:             srcDir,
+		packages:           make(map[string]*fileParser),
+	}
+}
+
+// parseSource parses the source file, which must be in the source directory
+// of p, with the imports and auxiliary files given in the format of the
+// -imports and -aux_files flags. The interfaces found by p are kept, so that
+// the source files of a package can share them. Only the types selected by
+// filter are mocked.
+func (p *fileParser) parseSource(source, importsSpec, auxFilesSpec string, filter *typeFilter) (*model.Package, error) {
+	packageImport, err := parsePackageImport(p.srcDir)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := parser.ParseFile(p.fileSet, source, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing source file %v: %v", source, err)
+	}
+	return p.parseFiles(source, packageImport, []*ast.File{file}, importsSpec, auxFilesSpec, filter)
+}
+
+// parseSourcePackage is like parseSource for the Go files of bpkg that match
+// the build constraints, excluding its tests. The interfaces of the package
+// may embed each other across its files.
+func (p *fileParser) parseSourcePackage(bpkg *build.Package, importsSpec, auxFilesSpec string, filter *typeFilter) (*model.Package, error) {
+	packageImport := bpkg.ImportPath
+	if build.IsLocalImport(packageImport) {
+		var err error
+		if packageImport, err = parsePackageImport(p.srcDir); err != nil {
+			return nil, err
+		}
+	}
+
+	var files []*ast.File
+	for _, name := range append(append([]string(nil), bpkg.GoFiles...), bpkg.CgoFiles...) {
+		file, err := parser.ParseFile(p.fileSet, filepath.Join(bpkg.Dir, name), nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing source file %v: %v", name, err)
+		}
+		files = append(files, file)
+	}
+	return p.parseFiles(packageImport, packageImport, files, importsSpec, auxFilesSpec, filter)
+}
+
+// parseFiles parses the files of the package packageImport, which are
+// described as source in errors.
+func (p *fileParser) parseFiles(source, packageImport string, files []*ast.File, importsSpec, auxFilesSpec string, filter *typeFilter) (*model.Package, error) {
+	var names []string
+	for _, file := range files {
+		names = append(names, typeNames(file)...)
+	}
+	if err := filter.checkMatched(source, names); err != nil {
+		return nil, err
 	}
 
 	// Handle -imports.
+	explicitImports := make(map[string]importedPackage)
 	dotImports := make(map[string]bool)
-	if *imports != "" {
-		for _, kv := range strings.Split(*imports, ",") {
+	if importsSpec != "" {
+		for _, kv := range strings.Split(importsSpec, ",") {
 			eq := strings.Index(kv, "=")
 			k, v := kv[:eq], kv[eq+1:]
 			if k == "." {
 				dotImports[v] = true
 			} else {
-				p.imports[k] = importedPkg{path: v}
+				explicitImports[k] = importedPkg{path: v}
 			}
 		}
 	}
 
 	// Handle -aux_files.
-	if err := p.parseAuxFiles(*auxFiles); err != nil {
+	base := *p
+	base.auxFiles = nil
+	base.filter = filter
+	if err := base.parseAuxFiles(auxFilesSpec); err != nil {
 		return nil, err
 	}
-	p.addAuxInterfacesFromFile(packageImport, file) // this file
+	// The files are auxiliary files of each other, so that their interfaces
+	// can embed each other.
+	for _, file := range files {
+		base.auxFiles = append(base.auxFiles, file)
+		base.addAuxInterfacesFromFile(packageImport, file)
+	}
 
-	pkg, err := p.parseFile(packageImport, file)
-	if err != nil {
-		return nil, err
+	pkg := &model.Package{PkgPath: packageImport}
+	for _, file := range files {
+		// The imports are those of this file only.
+		fp := base
+		fp.imports = make(map[string]importedPackage)
+		for k, v := range explicitImports {
+			fp.imports[k] = v
+		}
+
+		fpkg, err := fp.parseFile(packageImport, file)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Name = fpkg.Name
+		pkg.Interfaces = append(pkg.Interfaces, fpkg.Interfaces...)
+		pkg.Funcs = append(pkg.Funcs, fpkg.Funcs...)
+		for _, pkgPath := range fpkg.DotImports {
+			dotImports[pkgPath] = true
+		}
 	}
 	for pkgPath := range dotImports {
 		pkg.DotImports = append(pkg.DotImports, pkgPath)
 	}
+	sort.Strings(pkg.DotImports)
 	return pkg, nil
 }
 
+// splitList splits a comma-separated list, which may be empty.
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
+}
+
+// A typeFilter selects the types to mock by name, with the glob patterns of
+// path.Match.
+type typeFilter struct {
+	include []string // all types are included if empty
+	exclude []string
+}
+
+func newTypeFilter(include, exclude []string) (*typeFilter, error) {
+	f := &typeFilter{include: include, exclude: exclude}
+	for _, pattern := range f.patterns() {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("bad interface pattern %q: %v", pattern, err)
+		}
+	}
+	return f, nil
+}
+
+func (f *typeFilter) patterns() []string {
+	return append(append([]string(nil), f.include...), f.exclude...)
+}
+
+// matchesAny reports whether pattern matches any of names.
+func matchesAny(pattern string, names ...string) bool {
+	for _, name := range names {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// selects reports whether the type named name is to be mocked. A nil filter
+// selects all types.
+func (f *typeFilter) selects(name string) bool {
+	if f == nil {
+		return true
+	}
+	included := len(f.include) == 0
+	for _, pattern := range f.include {
+		included = included || matchesAny(pattern, name)
+	}
+	for _, pattern := range f.exclude {
+		if matchesAny(pattern, name) {
+			return false
+		}
+	}
+	return included
+}
+
+// selectsFunc reports whether the func type named name is to be mocked. Unlike
+// interfaces, func types are only mocked when an include pattern selects them.
+func (f *typeFilter) selectsFunc(name string) bool {
+	return f != nil && len(f.include) > 0 && f.selects(name)
+}
+
+// checkMatched returns an error naming the first include pattern that matches
+// none of the names of the types found in the source file. Exclude patterns
+// need not match anything.
+func (f *typeFilter) checkMatched(source string, names []string) error {
+	if f == nil {
+		return nil
+	}
+	for _, pattern := range f.include {
+		if matchesAny(pattern, names...) {
+			continue
+		}
+		if strings.ContainsAny(pattern, "*?[\\") {
+			return fmt.Errorf("%v has no interface or func type matching %v", source, pattern)
+		}
+		return fmt.Errorf("%v has no interface or func type %v", source, pattern)
+	}
+	return nil
+}
+
 type importedPackage interface {
 	Path() string
 	Parser() *fileParser
@@ -169,6 +374,8 @@ type fileParser struct {
 	auxFiles           []*ast.File
 	auxInterfaces      *interfaceCache
 	srcDir             string
+	packages           map[string]*fileParser // parsers of imported packages by path; shared
+	filter             *typeFilter            // types of the parsed file to mock; may be nil
 }
 
 func (p *fileParser) errorf(pos token.Pos, format string, args ...interface{}) error {
@@ -207,7 +414,8 @@ func (p *fileParser) addAuxInterfacesFromFile(pkg string, file *ast.File) {
 }
 
 // parseFile loads all file imports and auxiliary files import into the
-// fileParser, parses all file interfaces and returns package model.
+// fileParser, parses the file interfaces and func types selected by the
+// filter of p and returns package model.
 func (p *fileParser) parseFile(importPath string, file *ast.File) (*model.Package, error) {
 	allImports, dotImports := importsOfFile(file)
 	// Don't stomp imports provided by -imports. Those should take precedence.
@@ -229,29 +437,55 @@ func (p *fileParser) parseFile(importPath string, file *ast.File) (*model.Packag
 
 	var is []*model.Interface
 	for ni := range iterInterfaces(file) {
+		if !p.filter.selects(ni.name.Name) {
+			continue
+		}
 		i, err := p.parseInterface(ni.name.String(), importPath, ni)
 		if err != nil {
 			return nil, err
 		}
 		is = append(is, i)
 	}
+	var fns []*model.Func
+	for nf := range iterFuncs(file) {
+		if !p.filter.selectsFunc(nf.name.Name) {
+			continue
+		}
+		in, variadic, out, err := p.parseFunc(importPath, nf.ft, nil)
+		if err != nil {
+			return nil, err
+		}
+		fns = append(fns, &model.Func{
+			Name: nf.name.String(),
+			Type: &model.FuncType{In: in, Out: out, Variadic: variadic},
+		})
+	}
 	return &model.Package{
 		Name:       file.Name.String(),
 		PkgPath:    importPath,
 		Interfaces: is,
+		Funcs:      fns,
 		DotImports: dotImports,
 	}, nil
 }
 
 // parsePackage loads package specified by path, parses it and returns
-// a new fileParser with the parsed imports and interfaces.
+// a new fileParser with the parsed imports and interfaces. The parsers of
+// loaded packages are reused by p and the parsers it returns.
 func (p *fileParser) parsePackage(path string) (*fileParser, error) {
+	if newP, ok := p.packages[path]; ok {
+		return newP, nil
+	}
+	if p.packages == nil {
+		p.packages = make(map[string]*fileParser)
+	}
 	newP := &fileParser{
 		fileSet:            token.NewFileSet(),
 		imports:            make(map[string]importedPackage),
 		importedInterfaces: newInterfaceCache(),
 		auxInterfaces:      newInterfaceCache(),
 		srcDir:             p.srcDir,
+		packages:           p.packages,
 	}
 
 	var pkgs map[string]*ast.Package
@@ -271,6 +505,7 @@ func (p *fileParser) parsePackage(path string) (*fileParser, error) {
 			newP.imports[pkgName] = pkgI
 		}
 	}
+	p.packages[path] = newP
 	return newP, nil
 }
 
@@ -662,6 +897,18 @@ type namedInterface struct {
 	typeParams []*ast.Field
 }
 
+// typeNames returns the names of the interfaces and func types in file.
+func typeNames(file *ast.File) []string {
+	var names []string
+	for ni := range iterInterfaces(file) {
+		names = append(names, ni.name.Name)
+	}
+	for nf := range iterFuncs(file) {
+		names = append(names, nf.name.Name)
+	}
+	return names
+}
+
 // Create an iterator over all interfaces in file.
 func iterInterfaces(file *ast.File) <-chan *namedInterface {
 	ch := make(chan *namedInterface)
@@ -689,6 +936,39 @@ func iterInterfaces(file *ast.File) <-chan *namedInterface {
 	return ch
 }
 
+type namedFunc struct {
+	name *ast.Ident
+	ft   *ast.FuncType
+}
+
+// Create an iterator over all func types in file. Generic func types are
+// skipped, since their mocks would have no single type to return.
+func iterFuncs(file *ast.File) <-chan *namedFunc {
+	ch := make(chan *namedFunc)
+	go func() {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || len(getTypeSpecTypeParams(ts)) > 0 {
+					continue
+				}
+				ft, ok := ts.Type.(*ast.FuncType)
+				if !ok {
+					continue
+				}
+
+				ch <- &namedFunc{ts.Name, ft}
+			}
+		}
+		close(ch)
+	}()
+	return ch
+}
+
 // isVariadic returns whether the function is variadic.
 func isVariadic(f *ast.FuncType) bool {
 	nargs := len(f.Params.List)
@@ -3,6 +3,8 @@ package main
 import (
 	"go/parser"
 	"go/token"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -143,3 +145,116 @@ func TestParseArrayWithConstLength(t *testing.T) {
 		}
 	}
 }
+
+func TestTypeFilter(t *testing.T) {
+	f, err := newTypeFilter([]string{"Store*", "Notifier"}, []string{"*Internal"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for name, want := range map[string]bool{
+		"StoreReader":   true,
+		"Notifier":      true,
+		"StoreInternal": false,
+		"Listener":      false,
+	} {
+		if got := f.selects(name); got != want {
+			t.Errorf("selects(%v): got %v, want %v", name, got, want)
+		}
+	}
+
+	var all *typeFilter
+	if !all.selects("Listener") {
+		t.Error("a nil filter doesn't select all types")
+	}
+
+	if _, err := newTypeFilter([]string{"Store["}, nil); err == nil {
+		t.Error("expected an error for a bad pattern")
+	}
+}
+
+func TestFileParser_ParseSource_Filter(t *testing.T) {
+	srcDir, err := filepath.Abs("internal/tests/source_interfaces")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	source := filepath.Join(srcDir, "input.go")
+
+	tests := []struct {
+		include, exclude []string
+		wantErr          string
+	}{
+		{include: []string{"Missing"}, wantErr: "has no interface or func type Missing"},
+		{include: []string{"Missing*"}, wantErr: "has no interface or func type matching Missing*"},
+	}
+	for _, tt := range tests {
+		f, err := newTypeFilter(tt.include, tt.exclude)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		_, err = newFileParser(srcDir).parseSource(source, "", "", f)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("parseSource(%v, %v): got %v, want an error containing %q", tt.include, tt.exclude, err, tt.wantErr)
+		}
+	}
+
+	// Exclude patterns need not match anything.
+	f, err := newTypeFilter(nil, []string{"Store*", "Missing"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pkg, err := newFileParser(srcDir).parseSource(source, "", "", f)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pkg.Interfaces) != 1 || pkg.Interfaces[0].Name != "Notifier" || len(pkg.Funcs) != 0 {
+		t.Errorf("got interfaces %v and func types %v, want Notifier only", pkg.Interfaces, pkg.Funcs)
+	}
+
+	// Func types are only mocked when an include pattern selects them.
+	f, err = newTypeFilter([]string{"Notifier", "List*"}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pkg, err = newFileParser(srcDir).parseSource(source, "", "", f)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pkg.Interfaces) != 1 || pkg.Interfaces[0].Name != "Notifier" || len(pkg.Funcs) != 1 || pkg.Funcs[0].Name != "Listener" {
+		t.Errorf("got interfaces %v and func types %v, want Notifier and Listener", pkg.Interfaces, pkg.Funcs)
+	}
+}
+
+func TestFileParser_ParseSourcePackage(t *testing.T) {
+	for _, source := range []string{
+		"internal/tests/source_package",
+		"github.com/golang/mock/mockgen/internal/tests/source_package",
+	} {
+		t.Run(source, func(t *testing.T) {
+			bpkg, err := importSourcePackage(source)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			pkg, err := newFileParser(bpkg.Dir).parseSourcePackage(bpkg, "", "", nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if want := "github.com/golang/mock/mockgen/internal/tests/source_package"; pkg.PkgPath != want {
+				t.Errorf("got package path %v, want %v", pkg.PkgPath, want)
+			}
+			// ignored.go doesn't match the build constraints, and the tests
+			// aren't parsed.
+			var names []string
+			for _, intf := range pkg.Interfaces {
+				names = append(names, intf.Name)
+			}
+			if got, want := strings.Join(names, ","), "Reader,Store"; got != want {
+				t.Fatalf("got interfaces %v, want %v", got, want)
+			}
+			// Store embeds Reader of reader.go.
+			if got := len(pkg.Interfaces[1].Methods); got != 3 {
+				t.Errorf("got %d methods of Store, want 3", got)
+			}
+		})
+	}
+}
@@ -38,16 +38,23 @@ import (
 var (
 	progOnly   = flag.Bool("prog_only", false, "(reflect mode) Only generate the reflection program; write it to stdout and exit.")
 	execOnly   = flag.String("exec_only", "", "(reflect mode) If set, execute this reflection program.")
-	buildFlags = flag.String("build_flags", "", "(reflect mode) Additional flags for go build.")
+	buildFlags = flag.String("build_flags", "", "(reflect, package, struct, scan and config mode) Additional flags for go build.")
 )
 
 // reflectMode generates mocks via reflection on an interface.
 func reflectMode(importPath string, symbols []string) (*model.Package, error) {
+	return reflectSymbols(importPath, symbols, false)
+}
+
+// reflectSymbols builds a model of symbols via reflection. Struct types are
+// only allowed if structs is set, and get the interface of their exported
+// methods.
+func reflectSymbols(importPath string, symbols []string, structs bool) (*model.Package, error) {
 	if *execOnly != "" {
 		return run(*execOnly)
 	}
 
-	program, err := writeProgram(importPath, symbols)
+	program, err := writeProgram(importPath, symbols, structs)
 	if err != nil {
 		return nil, err
 	}
@@ -78,11 +85,40 @@ func reflectMode(importPath string, symbols []string) (*model.Package, error) {
 	return runInDir(program, "")
 }
 
-func writeProgram(importPath string, symbols []string) ([]byte, error) {
+// structMode generates an interface of the exported methods of a struct, and
+// a mock of it, via reflection on the struct. If methods is non-empty, only
+// the named methods are included.
+func structMode(importPath, name string, methods []string) (*model.Package, error) {
+	pkg, err := reflectSymbols(importPath, []string{name}, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(methods) == 0 {
+		return pkg, nil
+	}
+
+	intf := pkg.Interfaces[0]
+	byName := make(map[string]*model.Method, len(intf.Methods))
+	for _, m := range intf.Methods {
+		byName[m.Name] = m
+	}
+	intf.Methods = nil
+	for _, name := range methods {
+		m, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%v.%v has no exported method %v", importPath, intf.Name, name)
+		}
+		intf.AddMethod(m)
+	}
+	return pkg, nil
+}
+
+func writeProgram(importPath string, symbols []string, structs bool) ([]byte, error) {
 	var program bytes.Buffer
 	data := reflectData{
 		ImportPath: importPath,
 		Symbols:    symbols,
+		Structs:    structs,
 	}
 	if err := reflectProgram.Execute(&program, &data); err != nil {
 		return nil, err
@@ -182,9 +218,10 @@ func runInDir(program []byte, dir string) (*model.Package, error) {
 type reflectData struct {
 	ImportPath string
 	Symbols    []string
+	Structs    bool // whether struct types are allowed, in struct mode
 }
 
-// This program reflects on an interface value, and prints the
+// This program reflects on interface, func and struct types, and prints the
 // gob encoding of a model.Package to standard output.
 // JSON doesn't work because of the model.Type interface.
 var reflectProgram = template.Must(template.New("program").Parse(`
@@ -224,7 +261,24 @@ func main() {
 	}
 
 	for _, it := range its {
-		intf, err := model.InterfaceFromInterfaceType(it.typ)
+		if it.typ.Kind() == reflect.Func {
+			fn, err := model.FuncFromFuncType(it.typ)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Reflection: %v\n", err)
+				os.Exit(1)
+			}
+			fn.Name = it.sym
+			pkg.Funcs = append(pkg.Funcs, fn)
+			continue
+		}
+
+		fromType := model.InterfaceFromInterfaceType
+		{{- if .Structs}}
+		if it.typ.Kind() == reflect.Struct {
+			fromType = model.InterfaceFromStructType
+		}
+		{{- end}}
+		intf, err := fromType(it.typ)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Reflection: %v\n", err)
 			os.Exit(1)
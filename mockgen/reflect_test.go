@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestReflectMode_Struct(t *testing.T) {
+	const importPath = "github.com/golang/mock/mockgen/internal/tests/struct_mode"
+
+	// Structs are only mocked in struct mode.
+	if _, err := reflectMode(importPath, []string{"Client"}); err == nil {
+		t.Error("Expected an error for a struct in reflect mode")
+	}
+
+	pkg, err := structMode(importPath, "Client", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pkg.Interfaces) != 1 || pkg.Interfaces[0].Name != "Client" {
+		t.Errorf("Expected the interface of Client but got %v", pkg.Interfaces)
+	}
+}
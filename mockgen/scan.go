@@ -0,0 +1,199 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// This file contains the generation of the mocks of annotated interfaces.
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+var scan = flag.Bool("scan", false, "(scan mode) Generate the mocks of the interfaces annotated with //mockgen:generate in the packages matching the non-flag arguments; enables scan mode.")
+
+const generateDirective = "//mockgen:generate"
+
+// A scanJob generates a mock file of the annotated types of a package.
+type scanJob struct {
+	pkg         *packages.Package
+	destination string
+	packageOut  string
+	symbols     []string
+	mockNames   map[string]string
+}
+
+// scanMode generates the mocks of the annotated interfaces and func types in
+// the packages matching patterns. The packages are loaded and type checked
+// together, so that their common dependencies are only checked once. Packages
+// that fail to load are reported, and the mocks of the others are still
+// generated.
+func scanMode(patterns []string) error {
+	fset := token.NewFileSet()
+	pkgs, err := loadPackages(fset, *buildFlags, patterns...)
+	if err != nil {
+		return fmt.Errorf("loading packages %v: %v", strings.Join(patterns, " "), err)
+	}
+
+	var jobs []*scanJob
+	byDestination := make(map[string]*scanJob)
+	failedPkgs := 0
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			log.Printf("Loading package %v failed: %v", pkg.PkgPath, pkg.Errors[0])
+			failedPkgs++
+			continue
+		}
+		for _, file := range pkg.Syntax {
+			dir := filepath.Dir(fset.Position(file.Pos()).Filename)
+			for _, ts := range documentedTypes(file) {
+				for _, c := range ts.doc.List {
+					if !strings.HasPrefix(c.Text, generateDirective) {
+						continue
+					}
+					args, err := parseGenerateDirective(c.Text)
+					if err != nil {
+						return fmt.Errorf("%v: %v", fset.Position(c.Pos()), err)
+					}
+					dst := filepath.Join(dir, args["destination"])
+					job, ok := byDestination[dst]
+					if !ok {
+						job = &scanJob{
+							pkg:         pkg,
+							destination: dst,
+							packageOut:  args["package"],
+							mockNames:   make(map[string]string),
+						}
+						byDestination[dst] = job
+						jobs = append(jobs, job)
+					} else if job.pkg != pkg || job.packageOut != args["package"] {
+						return fmt.Errorf("%v: conflicting directives for %v", fset.Position(c.Pos()), dst)
+					}
+					job.symbols = append(job.symbols, ts.name)
+					if name := args["name"]; name != "" {
+						job.mockNames[ts.name] = name
+					}
+				}
+			}
+		}
+	}
+
+	c := newPackageChecker(fset)
+	failed := 0
+	for _, job := range jobs {
+		if err := job.run(c); err != nil {
+			log.Printf("Generating %v failed: %v", job.destination, err)
+			failed++
+		}
+	}
+	var failures []string
+	if failedPkgs > 0 {
+		failures = append(failures, fmt.Sprintf("%d of %d packages failed to load", failedPkgs, len(pkgs)))
+	}
+	if failed > 0 {
+		failures = append(failures, fmt.Sprintf("%d of %d mock files failed", failed, len(jobs)))
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (job *scanJob) run(c *packageChecker) error {
+	tpkg, err := c.check(job.pkg)
+	if err != nil {
+		return fmt.Errorf("type checking package %v: %v", job.pkg.PkgPath, err)
+	}
+	pkg, err := (&packageModeParser{}).parsePackage(tpkg, job.symbols)
+	if err != nil {
+		return err
+	}
+
+	outputPackageName := job.packageOut
+	if outputPackageName == "" {
+		outputPackageName = "mock_" + sanitize(pkg.Name)
+	}
+	g := newGenerator()
+	g.srcPackage = job.pkg.PkgPath
+	g.srcInterfaces = strings.Join(job.symbols, ",")
+	g.destination = job.destination
+	g.mockNames = job.mockNames
+	if err := g.Generate(pkg, outputPackageName, inferOutputPackagePath(job.destination)); err != nil {
+		return err
+	}
+	return writeOutput(job.destination, g.Output())
+}
+
+type documentedType struct {
+	name string
+	doc  *ast.CommentGroup
+}
+
+// documentedTypes returns the types declared in file that have doc comments.
+// The doc comment of a declaration of a single type is that of the type.
+func documentedTypes(file *ast.File) []documentedType {
+	var dts []documentedType
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			doc := ts.Doc
+			if doc == nil && len(gd.Specs) == 1 {
+				doc = gd.Doc
+			}
+			if doc != nil {
+				dts = append(dts, documentedType{ts.Name.Name, doc})
+			}
+		}
+	}
+	return dts
+}
+
+// parseGenerateDirective parses the space-separated key=value arguments of a
+// //mockgen:generate directive.
+func parseGenerateDirective(text string) (map[string]string, error) {
+	text = strings.TrimPrefix(text, generateDirective)
+	if text != "" && text[0] != ' ' && text[0] != '\t' {
+		return nil, fmt.Errorf("malformed directive %q", generateDirective+text)
+	}
+
+	args := make(map[string]string)
+	for _, field := range strings.Fields(text) {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("argument %q of %v is not of the form key=value", field, generateDirective)
+		}
+		switch kv[0] {
+		case "destination", "name", "package":
+			args[kv[0]] = kv[1]
+		default:
+			return nil, fmt.Errorf("unknown argument %q of %v", kv[0], generateDirective)
+		}
+	}
+	if args["destination"] == "" {
+		return nil, fmt.Errorf("%v requires a destination", generateDirective)
+	}
+	return args, nil
+}
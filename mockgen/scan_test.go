@@ -0,0 +1,96 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseGenerateDirective(t *testing.T) {
+	tests := []struct {
+		text     string
+		wantArgs map[string]string
+		wantErr  bool
+	}{
+		{
+			text:     "//mockgen:generate destination=mock/mock.go",
+			wantArgs: map[string]string{"destination": "mock/mock.go"},
+		},
+		{
+			text:     "//mockgen:generate  destination=mock_test.go name=FakeStore package=store",
+			wantArgs: map[string]string{"destination": "mock_test.go", "name": "FakeStore", "package": "store"},
+		},
+		{text: "//mockgen:generate", wantErr: true},
+		{text: "//mockgen:generate name=FakeStore", wantErr: true},
+		{text: "//mockgen:generate destination", wantErr: true},
+		{text: "//mockgen:generate destination=mock.go source=x.go", wantErr: true},
+		{text: "//mockgen:generated destination=mock.go", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			args, err := parseGenerateDirective(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseGenerateDirective(%q) error = %v, want error %v", tt.text, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("parseGenerateDirective(%q) = %v, want %v", tt.text, args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestScanMode_FailingPackage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"go.mod": "module example.com/scan\n",
+		"good/good.go": `package good
+
+//mockgen:generate destination=mock_good/mock.go
+type Store interface {
+	Get(key string) ([]byte, error)
+}
+`,
+		"bad/bad.go": `package bad
+
+//mockgen:generate destination=mock_bad/mock.go
+type Store interface {
+	Get(key string) ([]byte, error
+}
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	if err := scanMode([]string{"./..."}); err == nil || err.Error() != "1 of 2 packages failed to load" {
+		t.Errorf("scanMode: got %v, want 1 of 2 packages failed to load", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "good", "mock_good", "mock.go")); err != nil {
+		t.Errorf("the mocks of the package that loaded weren't generated: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "bad", "mock_bad", "mock.go")); err == nil {
+		t.Error("the mocks of the package that failed to load were generated")
+	}
+}
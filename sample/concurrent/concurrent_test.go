@@ -46,3 +46,31 @@ func TestConcurrentWorks(t *testing.T) {
 		t.Error("call failed:", err)
 	}
 }
+
+func BenchmarkConcurrentCalls(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	m := mock.NewMockMath(ctrl)
+	m.EXPECT().Sum(gomock.Any(), gomock.Any()).Return(3).AnyTimes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			m.Sum(1, 2)
+		}
+	})
+}
+
+func BenchmarkConcurrentStubs(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	m := mock.NewMockMath(ctrl)
+	m.STUB().Sum(gomock.Any(), gomock.Any()).Return(3)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			m.Sum(1, 2)
+		}
+	})
+}
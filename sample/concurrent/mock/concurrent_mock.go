@@ -19,12 +19,13 @@ type MockMath struct {
 // MockMathMockRecorder is the mock recorder for MockMath.
 type MockMathMockRecorder struct {
 	mock *MockMath
+	stub bool
 }
 
 // NewMockMath creates a new mock instance.
 func NewMockMath(ctrl *gomock.Controller) *MockMath {
 	mock := &MockMath{ctrl: ctrl}
-	mock.recorder = &MockMathMockRecorder{mock}
+	mock.recorder = &MockMathMockRecorder{mock: mock}
 	return mock
 }
 
@@ -33,6 +34,12 @@ func (m *MockMath) EXPECT() *MockMathMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockMath) STUB() *MockMathMockRecorder {
+	return &MockMathMockRecorder{mock: m, stub: true}
+}
+
 // Sum mocks base method.
 func (m *MockMath) Sum(arg0, arg1 int) int {
 	m.ctrl.T.Helper()
@@ -44,5 +51,8 @@ func (m *MockMath) Sum(arg0, arg1 int) int {
 // Sum indicates an expected call of Sum.
 func (mr *MockMathMockRecorder) Sum(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Sum", reflect.TypeOf((*MockMath)(nil).Sum), arg0, arg1)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sum", reflect.TypeOf((*MockMath)(nil).Sum), arg0, arg1)
 }
@@ -30,12 +30,13 @@ type MockIndex struct {
 // MockIndexMockRecorder is the mock recorder for MockIndex.
 type MockIndexMockRecorder struct {
 	mock *MockIndex
+	stub bool
 }
 
 // NewMockIndex creates a new mock instance.
 func NewMockIndex(ctrl *gomock.Controller) *MockIndex {
 	mock := &MockIndex{ctrl: ctrl}
-	mock.recorder = &MockIndexMockRecorder{mock}
+	mock.recorder = &MockIndexMockRecorder{mock: mock}
 	return mock
 }
 
@@ -44,6 +45,12 @@ func (m *MockIndex) EXPECT() *MockIndexMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockIndex) STUB() *MockIndexMockRecorder {
+	return &MockIndexMockRecorder{mock: m, stub: true}
+}
+
 // Anon mocks base method.
 func (m *MockIndex) Anon(arg0 string) {
 	m.ctrl.T.Helper()
@@ -53,6 +60,9 @@ func (m *MockIndex) Anon(arg0 string) {
 // Anon indicates an expected call of Anon.
 func (mr *MockIndexMockRecorder) Anon(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Anon", reflect.TypeOf((*MockIndex)(nil).Anon), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anon", reflect.TypeOf((*MockIndex)(nil).Anon), arg0)
 }
 
@@ -65,6 +75,9 @@ func (m *MockIndex) Chan(arg0 chan int, arg1 chan<- hash.Hash) {
 // Chan indicates an expected call of Chan.
 func (mr *MockIndexMockRecorder) Chan(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Chan", reflect.TypeOf((*MockIndex)(nil).Chan), arg0, arg1)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chan", reflect.TypeOf((*MockIndex)(nil).Chan), arg0, arg1)
 }
 
@@ -79,6 +92,9 @@ func (m *MockIndex) ConcreteRet() chan<- bool {
 // ConcreteRet indicates an expected call of ConcreteRet.
 func (mr *MockIndexMockRecorder) ConcreteRet() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ConcreteRet", reflect.TypeOf((*MockIndex)(nil).ConcreteRet))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConcreteRet", reflect.TypeOf((*MockIndex)(nil).ConcreteRet))
 }
 
@@ -96,6 +112,9 @@ func (m *MockIndex) Ellip(arg0 string, arg1 ...interface{}) {
 func (mr *MockIndexMockRecorder) Ellip(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0}, arg1...)
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Ellip", reflect.TypeOf((*MockIndex)(nil).Ellip), varargs...)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ellip", reflect.TypeOf((*MockIndex)(nil).Ellip), varargs...)
 }
 
@@ -112,6 +131,9 @@ func (m *MockIndex) EllipOnly(arg0 ...string) {
 // EllipOnly indicates an expected call of EllipOnly.
 func (mr *MockIndexMockRecorder) EllipOnly(arg0 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "EllipOnly", reflect.TypeOf((*MockIndex)(nil).EllipOnly), arg0...)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EllipOnly", reflect.TypeOf((*MockIndex)(nil).EllipOnly), arg0...)
 }
 
@@ -124,6 +146,9 @@ func (m *MockIndex) ForeignFour(arg0 imp_four.Imp4) {
 // ForeignFour indicates an expected call of ForeignFour.
 func (mr *MockIndexMockRecorder) ForeignFour(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ForeignFour", reflect.TypeOf((*MockIndex)(nil).ForeignFour), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForeignFour", reflect.TypeOf((*MockIndex)(nil).ForeignFour), arg0)
 }
 
@@ -136,6 +161,9 @@ func (m *MockIndex) ForeignOne(arg0 imp1.Imp1) {
 // ForeignOne indicates an expected call of ForeignOne.
 func (mr *MockIndexMockRecorder) ForeignOne(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ForeignOne", reflect.TypeOf((*MockIndex)(nil).ForeignOne), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForeignOne", reflect.TypeOf((*MockIndex)(nil).ForeignOne), arg0)
 }
 
@@ -148,6 +176,9 @@ func (m *MockIndex) ForeignThree(arg0 imp3.Imp3) {
 // ForeignThree indicates an expected call of ForeignThree.
 func (mr *MockIndexMockRecorder) ForeignThree(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ForeignThree", reflect.TypeOf((*MockIndex)(nil).ForeignThree), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForeignThree", reflect.TypeOf((*MockIndex)(nil).ForeignThree), arg0)
 }
 
@@ -160,6 +191,9 @@ func (m *MockIndex) ForeignTwo(arg0 imp2.Imp2) {
 // ForeignTwo indicates an expected call of ForeignTwo.
 func (mr *MockIndexMockRecorder) ForeignTwo(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ForeignTwo", reflect.TypeOf((*MockIndex)(nil).ForeignTwo), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForeignTwo", reflect.TypeOf((*MockIndex)(nil).ForeignTwo), arg0)
 }
 
@@ -172,6 +206,9 @@ func (m *MockIndex) Func(arg0 func(http.Request) (int, bool)) {
 // Func indicates an expected call of Func.
 func (mr *MockIndexMockRecorder) Func(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Func", reflect.TypeOf((*MockIndex)(nil).Func), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Func", reflect.TypeOf((*MockIndex)(nil).Func), arg0)
 }
 
@@ -186,6 +223,9 @@ func (m *MockIndex) Get(arg0 string) interface{} {
 // Get indicates an expected call of Get.
 func (mr *MockIndexMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIndex)(nil).Get), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIndex)(nil).Get), arg0)
 }
 
@@ -201,6 +241,9 @@ func (m *MockIndex) GetTwo(arg0, arg1 string) (interface{}, interface{}) {
 // GetTwo indicates an expected call of GetTwo.
 func (mr *MockIndexMockRecorder) GetTwo(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "GetTwo", reflect.TypeOf((*MockIndex)(nil).GetTwo), arg0, arg1)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwo", reflect.TypeOf((*MockIndex)(nil).GetTwo), arg0, arg1)
 }
 
@@ -213,6 +256,9 @@ func (m *MockIndex) Map(arg0 map[int]hash.Hash) {
 // Map indicates an expected call of Map.
 func (mr *MockIndexMockRecorder) Map(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockIndex)(nil).Map), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockIndex)(nil).Map), arg0)
 }
 
@@ -227,6 +273,9 @@ func (m *MockIndex) NillableRet() error {
 // NillableRet indicates an expected call of NillableRet.
 func (mr *MockIndexMockRecorder) NillableRet() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "NillableRet", reflect.TypeOf((*MockIndex)(nil).NillableRet))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NillableRet", reflect.TypeOf((*MockIndex)(nil).NillableRet))
 }
 
@@ -241,6 +290,9 @@ func (m *MockIndex) Other() hash.Hash {
 // Other indicates an expected call of Other.
 func (mr *MockIndexMockRecorder) Other() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Other", reflect.TypeOf((*MockIndex)(nil).Other))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Other", reflect.TypeOf((*MockIndex)(nil).Other))
 }
 
@@ -253,6 +305,9 @@ func (m *MockIndex) Ptr(arg0 *int) {
 // Ptr indicates an expected call of Ptr.
 func (mr *MockIndexMockRecorder) Ptr(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Ptr", reflect.TypeOf((*MockIndex)(nil).Ptr), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ptr", reflect.TypeOf((*MockIndex)(nil).Ptr), arg0)
 }
 
@@ -265,6 +320,9 @@ func (m *MockIndex) Put(arg0 string, arg1 interface{}) {
 // Put indicates an expected call of Put.
 func (mr *MockIndexMockRecorder) Put(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockIndex)(nil).Put), arg0, arg1)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockIndex)(nil).Put), arg0, arg1)
 }
 
@@ -279,6 +337,9 @@ func (m *MockIndex) Slice(arg0 []int, arg1 []byte) [3]int {
 // Slice indicates an expected call of Slice.
 func (mr *MockIndexMockRecorder) Slice(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Slice", reflect.TypeOf((*MockIndex)(nil).Slice), arg0, arg1)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slice", reflect.TypeOf((*MockIndex)(nil).Slice), arg0, arg1)
 }
 
@@ -291,6 +352,9 @@ func (m *MockIndex) Struct(arg0 struct{}) {
 // Struct indicates an expected call of Struct.
 func (mr *MockIndexMockRecorder) Struct(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Struct", reflect.TypeOf((*MockIndex)(nil).Struct), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Struct", reflect.TypeOf((*MockIndex)(nil).Struct), arg0)
 }
 
@@ -303,6 +367,9 @@ func (m *MockIndex) StructChan(arg0 chan struct{}) {
 // StructChan indicates an expected call of StructChan.
 func (mr *MockIndexMockRecorder) StructChan(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "StructChan", reflect.TypeOf((*MockIndex)(nil).StructChan), arg0)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructChan", reflect.TypeOf((*MockIndex)(nil).StructChan), arg0)
 }
 
@@ -315,6 +382,9 @@ func (m *MockIndex) Summary(arg0 *bytes.Buffer, arg1 io.Writer) {
 // Summary indicates an expected call of Summary.
 func (mr *MockIndexMockRecorder) Summary(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockIndex)(nil).Summary), arg0, arg1)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockIndex)(nil).Summary), arg0, arg1)
 }
 
@@ -327,6 +397,9 @@ func (m *MockIndex) Templates(arg0 template.CSS, arg1 template0.FuncMap) {
 // Templates indicates an expected call of Templates.
 func (mr *MockIndexMockRecorder) Templates(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "Templates", reflect.TypeOf((*MockIndex)(nil).Templates), arg0, arg1)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Templates", reflect.TypeOf((*MockIndex)(nil).Templates), arg0, arg1)
 }
 
@@ -339,12 +412,13 @@ type MockEmbed struct {
 // MockEmbedMockRecorder is the mock recorder for MockEmbed.
 type MockEmbedMockRecorder struct {
 	mock *MockEmbed
+	stub bool
 }
 
 // NewMockEmbed creates a new mock instance.
 func NewMockEmbed(ctrl *gomock.Controller) *MockEmbed {
 	mock := &MockEmbed{ctrl: ctrl}
-	mock.recorder = &MockEmbedMockRecorder{mock}
+	mock.recorder = &MockEmbedMockRecorder{mock: mock}
 	return mock
 }
 
@@ -353,6 +427,12 @@ func (m *MockEmbed) EXPECT() *MockEmbedMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockEmbed) STUB() *MockEmbedMockRecorder {
+	return &MockEmbedMockRecorder{mock: m, stub: true}
+}
+
 // EmbeddedMethod mocks base method.
 func (m *MockEmbed) EmbeddedMethod() {
 	m.ctrl.T.Helper()
@@ -362,6 +442,9 @@ func (m *MockEmbed) EmbeddedMethod() {
 // EmbeddedMethod indicates an expected call of EmbeddedMethod.
 func (mr *MockEmbedMockRecorder) EmbeddedMethod() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "EmbeddedMethod", reflect.TypeOf((*MockEmbed)(nil).EmbeddedMethod))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbeddedMethod", reflect.TypeOf((*MockEmbed)(nil).EmbeddedMethod))
 }
 
@@ -376,6 +459,9 @@ func (m *MockEmbed) ForeignEmbeddedMethod() *bufio.Reader {
 // ForeignEmbeddedMethod indicates an expected call of ForeignEmbeddedMethod.
 func (mr *MockEmbedMockRecorder) ForeignEmbeddedMethod() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ForeignEmbeddedMethod", reflect.TypeOf((*MockEmbed)(nil).ForeignEmbeddedMethod))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForeignEmbeddedMethod", reflect.TypeOf((*MockEmbed)(nil).ForeignEmbeddedMethod))
 }
 
@@ -388,6 +474,9 @@ func (m *MockEmbed) ImplicitPackage(arg0 string, arg1 imp1.ImpT, arg2 []imp1.Imp
 // ImplicitPackage indicates an expected call of ImplicitPackage.
 func (mr *MockEmbedMockRecorder) ImplicitPackage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "ImplicitPackage", reflect.TypeOf((*MockEmbed)(nil).ImplicitPackage), arg0, arg1, arg2, arg3, arg4)
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImplicitPackage", reflect.TypeOf((*MockEmbed)(nil).ImplicitPackage), arg0, arg1, arg2, arg3, arg4)
 }
 
@@ -400,6 +489,9 @@ func (m *MockEmbed) RegularMethod() {
 // RegularMethod indicates an expected call of RegularMethod.
 func (mr *MockEmbedMockRecorder) RegularMethod() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "RegularMethod", reflect.TypeOf((*MockEmbed)(nil).RegularMethod))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegularMethod", reflect.TypeOf((*MockEmbed)(nil).RegularMethod))
 }
 
@@ -412,12 +504,13 @@ type MockEmbedded struct {
 // MockEmbeddedMockRecorder is the mock recorder for MockEmbedded.
 type MockEmbeddedMockRecorder struct {
 	mock *MockEmbedded
+	stub bool
 }
 
 // NewMockEmbedded creates a new mock instance.
 func NewMockEmbedded(ctrl *gomock.Controller) *MockEmbedded {
 	mock := &MockEmbedded{ctrl: ctrl}
-	mock.recorder = &MockEmbeddedMockRecorder{mock}
+	mock.recorder = &MockEmbeddedMockRecorder{mock: mock}
 	return mock
 }
 
@@ -426,6 +519,12 @@ func (m *MockEmbedded) EXPECT() *MockEmbeddedMockRecorder {
 	return m.recorder
 }
 
+// STUB returns an object that allows the caller to define default behavior
+// that is used when no expected call matches, and is not verified.
+func (m *MockEmbedded) STUB() *MockEmbeddedMockRecorder {
+	return &MockEmbeddedMockRecorder{mock: m, stub: true}
+}
+
 // EmbeddedMethod mocks base method.
 func (m *MockEmbedded) EmbeddedMethod() {
 	m.ctrl.T.Helper()
@@ -435,5 +534,8 @@ func (m *MockEmbedded) EmbeddedMethod() {
 // EmbeddedMethod indicates an expected call of EmbeddedMethod.
 func (mr *MockEmbeddedMockRecorder) EmbeddedMethod() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
+	if mr.stub {
+		return mr.mock.ctrl.RecordStubWithMethodType(mr.mock, "EmbeddedMethod", reflect.TypeOf((*MockEmbedded)(nil).EmbeddedMethod))
+	}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbeddedMethod", reflect.TypeOf((*MockEmbedded)(nil).EmbeddedMethod))
 }
@@ -2,6 +2,8 @@
 package user_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -120,6 +122,23 @@ func TestVariadicFunction(t *testing.T) {
 	mockIndex.Ellip("%d")
 }
 
+func TestStub(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIndex := NewMockIndex(ctrl)
+	// Stubs define default behavior and are not verified.
+	mockIndex.STUB().Get(gomock.Any()).Return("default")
+	mockIndex.EXPECT().Get("a").Return("a")
+
+	if v := mockIndex.Get("a"); v != "a" {
+		t.Errorf("Get(a): got %v, want a", v)
+	}
+	if v := mockIndex.Get("b"); v != "default" {
+		t.Errorf("Get(b): got %v, want default", v)
+	}
+}
+
 func TestGrabPointer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -159,10 +178,36 @@ func TestExpectTrueNil(t *testing.T) {
 	mockIndex.Ptr(nil)          // this nil is a nil *int
 }
 
+// fatalReporter is a gomock.TestReporter that panics with the message of the
+// first fatal failure.
+type fatalReporter struct {
+	t *testing.T
+}
+
+func (r fatalReporter) Errorf(format string, args ...interface{}) {
+	r.t.Errorf(format, args...)
+}
+
+func (r fatalReporter) Fatalf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+// expectFatal calls f, and checks that it fails with a message containing want.
+func expectFatal(t *testing.T, want string, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, want) {
+			t.Errorf("got failure %v, want one containing %q", r, want)
+		}
+	}()
+	f()
+}
+
 func TestDoAndReturnSignature(t *testing.T) {
 	t.Run("wrong number of return args", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
+		ctrl := gomock.NewController(fatalReporter{t})
 
 		mockIndex := NewMockIndex(ctrl)
 
@@ -170,18 +215,13 @@ func TestDoAndReturnSignature(t *testing.T) {
 			func(_ []int, _ []byte) {},
 		)
 
-		defer func() {
-			if r := recover(); r == nil {
-				t.Error("expected panic")
-			}
-		}()
-
-		mockIndex.Slice([]int{0}, []byte("meow"))
+		expectFatal(t, "wrong number of results of *user_test.MockIndex.Slice: got 0, want 1", func() {
+			mockIndex.Slice([]int{0}, []byte("meow"))
+		})
 	})
 
 	t.Run("wrong type of return arg", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
+		ctrl := gomock.NewController(fatalReporter{t})
 
 		mockIndex := NewMockIndex(ctrl)
 
@@ -190,6 +230,8 @@ func TestDoAndReturnSignature(t *testing.T) {
 				return true
 			})
 
-		mockIndex.Slice([]int{0}, []byte("meow"))
+		expectFatal(t, "wrong type of result 0 of *user_test.MockIndex.Slice: bool is not assignable to [3]int", func() {
+			mockIndex.Slice([]int{0}, []byte("meow"))
+		})
 	})
 }